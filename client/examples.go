@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/nandha854/train-ticket-service/proto"
 	"google.golang.org/grpc"
@@ -22,6 +23,7 @@ func main() {
     defer conn.Close()
 
     client := proto.NewTicketServiceClient(conn)
+    admin := proto.NewAdminServiceClient(conn)
 
     // Purchase Ticket
     user := &proto.User{Email: "test@example.com", FirstName: "Nandha", LastName: "Kumar"}
@@ -29,6 +31,7 @@ func main() {
         From: "London",
         To:   "France",
         User: user,
+        PaymentToken: "tok_visa",
     })
 
 	if err != nil {
@@ -36,11 +39,23 @@ func main() {
     }
     log.Printf("Purchased Ticket: %v", purchaseResp)
 
+	// A declined card books nothing
+	_, err = client.PurchaseTicket(context.Background(), &proto.PurchaseTicketRequest{
+		From:         "London",
+		To:           "France",
+		User:         &proto.User{Email: "declined@example.com", FirstName: "Ravi", LastName: "Shah"},
+		PaymentToken: "tok_declined",
+	})
+	log.Printf("Purchase with declined card: %v", err)
+
 	user1 := &proto.User{Email: "test23@example.com", FirstName: "Nandha", LastName: "Kumar"}
     purchaseResp1, err := client.PurchaseTicket(context.Background(), &proto.PurchaseTicketRequest{
         From: "London",
         To:   "France",
         User: user1,
+        SeatPreferences: &proto.SeatPreferences{
+            Preferred: []proto.SeatFeature{proto.SeatFeature_SEAT_FEATURE_POWER_SOCKET},
+        },
     })
 
 
@@ -63,12 +78,118 @@ func main() {
     }
     log.Printf("Purchased Ticket: %v", purchaseResp2)
 
+	// Lock a price with a quote, then buy at it
+	quoteResp, err := client.GetPriceQuote(context.Background(), &proto.GetPriceQuoteRequest{From: "London", To: "France"})
+	if err != nil {
+		log.Fatalf("GetPriceQuote failed: %v", err)
+	}
+	log.Printf("Price Quote: %v", quoteResp)
+
+	quotedResp, err := client.PurchaseTicket(context.Background(), &proto.PurchaseTicketRequest{
+		From: "London",
+		To:   "France",
+		User: &proto.User{Email: "quoted@example.com", FirstName: "Meena", LastName: "Iyer"},
+		QuoteId: quoteResp.QuoteId,
+	})
+	if err != nil {
+		log.Fatalf("PurchaseTicket with quote failed: %v", err)
+	}
+	log.Printf("Purchased Ticket at quoted price: %v", quotedResp)
+
+	// Create a promo code and buy with it
+	promoResp, err := admin.CreatePromoCode(context.Background(), &proto.PromoCode{Code: "SPRING15", PercentOff: 15, MaxUsesPerEmail: 1})
+	if err != nil {
+		log.Fatalf("CreatePromoCode failed: %v", err)
+	}
+	log.Printf("Created Promo Code: %v", promoResp)
+
+	discountedResp, err := client.PurchaseTicket(context.Background(), &proto.PurchaseTicketRequest{
+		From: "London",
+		To:   "France",
+		User: &proto.User{Email: "promo@example.com", FirstName: "Kavya", LastName: "Menon"},
+		PromoCodes: []string{"SPRING15"},
+	})
+	if err != nil {
+		log.Fatalf("PurchaseTicket with promo code failed: %v", err)
+	}
+	log.Printf("Purchased Ticket: original %v, discount %v, paid %v", discountedResp.OriginalPrice, discountedResp.Discount, discountedResp.Price)
+	for _, item := range discountedResp.LineItems {
+		log.Printf("  %s: %v", item.Description, item.Amount)
+	}
+
+	promoCodesResp, err := admin.ListPromoCodes(context.Background(), &proto.ListPromoCodesRequest{})
+	if err != nil {
+		log.Fatalf("ListPromoCodes failed: %v", err)
+	}
+	log.Printf("Promo Codes: %v", promoCodesResp)
+
+	// Purchase Group Ticket
+	groupResp, err := client.PurchaseGroupTicket(context.Background(), &proto.PurchaseGroupTicketRequest{
+		From: "London",
+		To:   "France",
+		Passengers: []*proto.User{
+			{Email: "group@example.com", FirstName: "Asha", LastName: "Rao"},
+			{FirstName: "Ravi", LastName: "Rao"},
+			{FirstName: "Mira", LastName: "Rao", PassengerType: proto.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: "2016-04-02"},
+			{FirstName: "Kiran", LastName: "Rao", PassengerType: proto.PassengerType_PASSENGER_TYPE_INFANT, DateOfBirth: time.Now().AddDate(-1, 0, 0).Format(time.DateOnly)},
+		},
+	})
+	if err != nil {
+		log.Fatalf("PurchaseGroupTicket failed: %v", err)
+	}
+	log.Printf("Purchased Group Ticket: %v", groupResp)
+
+	// Hold a seat, then pay for it
+	holdResp, err := client.HoldSeats(context.Background(), &proto.HoldSeatsRequest{
+		From:       "London",
+		To:         "France",
+		TtlSeconds: 300,
+	})
+	if err != nil {
+		log.Fatalf("HoldSeats failed: %v", err)
+	}
+	log.Printf("Held Seats: %v", holdResp)
+
+	confirmResp, err := client.ConfirmHold(context.Background(), &proto.ConfirmHoldRequest{
+		HoldId:     holdResp.HoldId,
+		Passengers: []*proto.User{{Email: "hold@example.com", FirstName: "Mira", LastName: "Das"}},
+	})
+	if err != nil {
+		log.Fatalf("ConfirmHold failed: %v", err)
+	}
+	log.Printf("Confirmed Hold: %v", confirmResp)
+
+	// Join the waitlist, check the position and leave it again
+	waitlistResp, err := client.JoinWaitlist(context.Background(), &proto.JoinWaitlistRequest{
+		From: "London",
+		To:   "France",
+		User: &proto.User{Email: "waiting@example.com", FirstName: "Lena", LastName: "Paul"},
+	})
+	if err != nil {
+		log.Fatalf("JoinWaitlist failed: %v", err)
+	}
+	log.Printf("Joined Waitlist: %v", waitlistResp)
+
+	positionResp, err := client.GetWaitlistPosition(context.Background(), &proto.GetWaitlistPositionRequest{WaitlistId: waitlistResp.WaitlistId})
+	if err != nil {
+		log.Fatalf("GetWaitlistPosition failed: %v", err)
+	}
+	log.Printf("Waitlist Position: %v", positionResp)
+
+	if positionResp.BookingReference == "" {
+		leaveResp, err := client.LeaveWaitlist(context.Background(), &proto.LeaveWaitlistRequest{WaitlistId: waitlistResp.WaitlistId})
+		if err != nil {
+			log.Fatalf("LeaveWaitlist failed: %v", err)
+		}
+		log.Printf("Left Waitlist: %v", leaveResp)
+	}
+
 	// Get Ticket
-	getResp, err := client.GetReceipt(context.Background(), &proto.GetReceiptRequest{ Email: user.Email })
+	getResp, err := client.GetReceipt(context.Background(), &proto.GetReceiptRequest{ Email: user.Email, DisplayCurrency: "EUR" })
 	if err != nil {
 		log.Fatalf("GetTicket failed: %v", err)
 	}
-	log.Printf("Get Ticket: %v", getResp)
+	log.Printf("Get Ticket: %v, price in euros: %v", getResp, getResp.GetDisplayPrice())
 
 	// Get User by Section
 	userResp, err := client.GetUsersBySection(context.Background(), &proto.GetUsersBySectionRequest{ Section: "A" })
@@ -77,6 +198,20 @@ func main() {
 	}
 	log.Printf("Get Users by Section: %v", userResp)
 
+	// Page through the manifest of section A by surname
+	manifestReq := &proto.GetUsersBySectionRequest{Section: "A", PageSize: 10, SortBy: proto.ManifestSortOrder_MANIFEST_SORT_ORDER_SURNAME}
+	for {
+		page, err := client.GetUsersBySection(context.Background(), manifestReq)
+		if err != nil {
+			log.Fatalf("GetUsersBySection failed: %v", err)
+		}
+		log.Printf("Manifest page: %d of %d passengers", len(page.Users), page.TotalSize)
+		if page.NextPageToken == "" {
+			break
+		}
+		manifestReq.PageToken = page.NextPageToken
+	}
+
 	// Modify User Seat	
 	modifyResp, err := client.ModifyUserSeat(context.Background(), &proto.ModifyUserSeatRequest{
 		Email: user.Email,
@@ -87,10 +222,58 @@ func main() {
 	}
 	log.Printf("Modify User Seat: %v", modifyResp)
 
+	// Upgrade to first class; the receipt carries the fare difference to pay
+	upgradeResp, err := client.ModifyUserSeat(context.Background(), &proto.ModifyUserSeatRequest{
+		Email: user.Email,
+		NewSeat: &proto.Seat{SeatNumber: 1, Section: "F"},
+	})
+	if err != nil {
+		log.Fatalf("ModifyUserSeat upgrade failed: %v", err)
+	}
+	log.Printf("Upgrade to first class: %v, fare difference: %v", upgradeResp, upgradeResp.GetFareDifference())
+
+	// List Bookings by Email
+	bookingsResp, err := client.ListBookingsByEmail(context.Background(), &proto.ListBookingsByEmailRequest{ Email: user.Email })
+	if err != nil {
+		log.Fatalf("ListBookingsByEmail failed: %v", err)
+	}
+	log.Printf("List Bookings by Email: %v", bookingsResp)
+
 	// Remove User
-	removeResp, err := client.RemoveUser(context.Background(), &proto.RemoveUserRequest{ Email: user.Email })
+	removeResp, err := client.RemoveUser(context.Background(), &proto.RemoveUserRequest{ BookingReference: purchaseResp.BookingReference })
 	if err != nil {
 		log.Fatalf("RemoveUser failed: %v", err)
 	}
 	log.Printf("Remove User: %v", removeResp)
+
+	refundsResp, err := client.ListRefunds(context.Background(), &proto.ListRefundsRequest{Email: user.Email})
+	if err != nil {
+		log.Fatalf("ListRefunds failed: %v", err)
+	}
+	log.Printf("Refunds: %v", refundsResp.Refunds)
+
+	// Take seat 1 of section B out of sale for maintenance, then add a section
+	blockedResp, err := admin.BlockSeat(context.Background(), &proto.BlockSeatRequest{Section: "B", SeatNumber: 1})
+	if err != nil {
+		log.Fatalf("BlockSeat failed: %v", err)
+	}
+	log.Printf("Blocked seat: %v", blockedResp)
+	sectionResp, err := admin.AddSection(context.Background(), &proto.AddSectionRequest{Section: "C", MaxSeats: 40})
+	if err != nil {
+		log.Fatalf("AddSection failed: %v", err)
+	}
+	log.Printf("Added section: %v", sectionResp)
+
+	// Watch Availability: the first update is a snapshot of section A
+	watchCtx, cancelWatch := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelWatch()
+	watch, err := client.WatchAvailability(watchCtx, &proto.WatchAvailabilityRequest{Section: "A"})
+	if err != nil {
+		log.Fatalf("WatchAvailability failed: %v", err)
+	}
+	update, err := watch.Recv()
+	if err != nil {
+		log.Fatalf("WatchAvailability failed: %v", err)
+	}
+	log.Printf("Availability of section A at sequence %d: %d seats", update.Sequence, len(update.GetSnapshot().GetSeats()))
 }
\ No newline at end of file
@@ -0,0 +1,46 @@
+// Command seatmap prints the seat map of a departure for station staff.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/nandha854/train-ticket-service/proto"
+	"github.com/nandha854/train-ticket-service/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+var (
+	addr      = flag.String("addr", "localhost:50051", "server address")
+	departure = flag.String("departure", "", "departure ID; empty for the default departure")
+	section   = flag.String("section", "", "section to show; empty for every section")
+	from      = flag.String("from", "", "show seat states for a journey from this station")
+	to        = flag.String("to", "", "show seat states for a journey to this station")
+)
+
+func main() {
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer conn.Close()
+
+	client := proto.NewTicketServiceClient(conn)
+
+	seatMap, err := client.GetSeatMap(context.Background(), &proto.GetSeatMapRequest{
+		DepartureId: *departure,
+		Section:     *section,
+		From:        *from,
+		To:          *to,
+	})
+	if err != nil {
+		log.Fatalf("GetSeatMap failed: %v", err)
+	}
+
+	fmt.Print(service.RenderSeatMap(seatMap))
+}
@@ -1,42 +1,188 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
 	"log"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	pb "github.com/nandha854/train-ticket-service/proto"
 	"github.com/nandha854/train-ticket-service/service"
 	"google.golang.org/grpc"
 )
 
+var (
+	configPath    = flag.String("config", "", "path of a .yaml, .yml or .json config file; defaults to $"+service.EnvConfigPath+" or the built-in config")
+	port          = flag.Int("port", 0, "port to listen on, overriding the config and $"+service.EnvPort)
+	stations      = flag.String("stations", "", "comma-separated stations, overriding the config and $"+service.EnvStations)
+	sections      = flag.String("sections", "", "JSON array of sections, overriding the config and $"+service.EnvSections)
+	fares         = flag.String("fares", "", "JSON array of fares, overriding the config and $"+service.EnvFares)
+	storeKind     = flag.String("store", "memory", "booking store: memory, file or wal")
+	dataPath      = flag.String("data", "bookings.json", "path of the booking data file (file store) or directory (wal store)")
+	snapshotEvery = flag.Int("snapshot-every", 1000, "number of wal records between snapshots")
+	holdSweep     = flag.Duration("hold-sweep-interval", 10*time.Second, "how often expired seat holds are released")
+	configCheck   = flag.Duration("config-check-interval", 5*time.Second, "how often the config file is checked for changes to reload")
+)
+
+// newStore opens the booking store selected on the command line.
+func newStore() (service.Store, error) {
+	switch *storeKind {
+	case "memory":
+		return service.NewMemoryStore(), nil
+	case "file":
+		return service.NewFileStore(*dataPath)
+	case "wal":
+		return service.NewWALStore(*dataPath, *snapshotEvery)
+	default:
+		return nil, fmt.Errorf("unknown store %q", *storeKind)
+	}
+}
+
+// configFile returns the path of the config file named on the command line or
+// in the environment, or an empty string for the built-in config.
+func configFile() string {
+	if *configPath != "" {
+		return *configPath
+	}
+	return os.Getenv(service.EnvConfigPath)
+}
+
+// loadConfig loads the config file named on the command line or in the
+// environment, then applies environment and flag overrides.
+func loadConfig() (service.Config, error) {
+	path := configFile()
+
+	config := service.DefaultConfig()
+	if path != "" {
+		loaded, err := service.LoadConfig(path)
+		if err != nil {
+			return config, err
+		}
+		config = loaded
+	}
+
+	return config, applyOverrides(&config)
+}
+
+// applyOverrides applies environment and flag overrides to a config, a flag
+// taking precedence over its environment variable, and validates the result.
+func applyOverrides(config *service.Config) error {
+	flags := map[string]string{service.EnvStations: *stations, service.EnvSections: *sections, service.EnvFares: *fares}
+	if *port != 0 {
+		flags[service.EnvPort] = fmt.Sprint(*port)
+	}
+	return config.ApplyEnv(func(key string) string {
+		if value := flags[key]; value != "" {
+			return value
+		}
+		return os.Getenv(key)
+	})
+}
+
 func main(){
+	flag.Parse()
+
+	// Load the sections, stations and fares to serve
+	config, err := loadConfig()
+	if err != nil {
+		log.Fatalf("invalid config: %v", err)
+	}
+
+	// Open the booking store
+	store, err := newStore()
+	if err != nil {
+		log.Fatalf("failed to open store: %v", err)
+	}
+
 	// Create a new gRPC server 
 	server := grpc.NewServer() 
-	sectionConfigs := []service.SectionConfigs{
-		{SectionName: "A", MaxSeats: 50},
-		{SectionName: "B", MaxSeats: 50},
-	}
 
 	// Initialize a new SeatManager
-	seatManager := service.NewSeatManager(sectionConfigs)
+	seatManager := service.NewSeatManager(config.SectionConfigs(), store)
+
+	// Initialize a stationConnection, with fares in pence
+	connectionStations := config.StationConnections()
+
+	// Restore bookings saved by a previous run
+	ticketManager := service.NewTicketManager(seatManager, connectionStations, store)
+	if err := ticketManager.Restore(); err != nil {
+		log.Fatalf("failed to restore bookings: %v", err)
+	}
+
+	// Fares of other classes; legs without one are charged the standard fare
+	for class, fares := range config.FareTables() {
+		if err := ticketManager.Routes.AddFareTable(class, fares); err != nil {
+			log.Fatalf("failed to add fare table: %v", err)
+		}
+	}
+
+	// Prices can also be shown in euros and US dollars
+	for currency, rate := range map[string]string{"EUR": "1.17", "USD": "1.27"} {
+		if err := ticketManager.Rates.SetRate(service.DefaultCurrency, currency, rate); err != nil {
+			log.Fatalf("failed to set exchange rate: %v", err)
+		}
+	}
+
+	// Bookings pay a booking fee, and UK VAT on journeys from London
+	if err := ticketManager.Fees.SetRules(service.FeeRules{
+		BookingFee:    150,
+		Jurisdictions: map[string]string{"London": "UK"},
+		VATPercent:    map[string]float64{"UK": 20},
+	}); err != nil {
+		log.Fatalf("failed to set fee rules: %v", err)
+	}
+
+	// Take payment with the local fake provider
+	ticketManager.Payments = service.NewFakePaymentProvider()
+
+	// First class tickets cost 10.00 to cancel up to two days before departure
+	// and are non-refundable after that; standard tickets are fully refundable
+	if err := ticketManager.SetCancellationPolicy(service.FareClassFirst, service.CancellationPolicy{
+		Bands: []service.CancellationBand{{MinNotice: 48 * time.Hour, RefundPercent: 100, Fee: 1000}},
+	}); err != nil {
+		log.Fatalf("failed to set cancellation policy: %v", err)
+	}
+
+	// Fares rise as the train fills up
+	if err := ticketManager.Pricing.SetRules(service.PricingRules{
+		Occupancy: []service.OccupancyBand{
+			{MinPercent: 75, Multiplier: 1.25},
+			{MinPercent: 90, Multiplier: 1.5},
+		},
+	}); err != nil {
+		log.Fatalf("failed to set pricing rules: %v", err)
+	}
+
+	// Release expired seat holds in the background
+	go ticketManager.RunHoldSweeper(context.Background(), *holdSweep)
 
-	// Initialize a stationConnection
-	connectionStations := map[string]float64{
-		"London-France": 20.00,
+	// Reload fares and sections when the config file changes or on SIGHUP
+	if path := configFile(); path != "" {
+		hangups := make(chan os.Signal, 1)
+		signal.Notify(hangups, syscall.SIGHUP)
+		reloader := service.NewConfigReloader(path, ticketManager)
+		reloader.Override = applyOverrides
+		go reloader.Run(context.Background(), *configCheck, hangups)
 	}
 
 	// Register the service with the server 
-	pb.RegisterTicketServiceServer(server, service.NewTicketManager(seatManager, connectionStations)) 
+	pb.RegisterTicketServiceServer(server, ticketManager) 
+	pb.RegisterAdminServiceServer(server, service.NewAdminServer(ticketManager))
 
-	// Start listening on a port (e.g., 50051) 
-	listen, err := net.Listen("tcp", ":50051") 
+	// Start listening on the configured port (50051 by default) 
+	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Port)) 
 
 	if err != nil { 
 		log.Fatalf("failed to listen: %v", err)
 	} 
 
 	// Start the gRPC server 
-	log.Println("Server listening on port 50051...") 
+	log.Printf("Server listening on port %d...", config.Port) 
 	
 	if err := server.Serve(listen); err != nil {
 		 log.Fatalf("failed to serve: %v", err)
@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TicketService_PurchaseTicket_FullMethodName    = "/ticketBooking.TicketService/PurchaseTicket"
-	TicketService_GetReceipt_FullMethodName        = "/ticketBooking.TicketService/GetReceipt"
-	TicketService_GetUsersBySection_FullMethodName = "/ticketBooking.TicketService/GetUsersBySection"
-	TicketService_RemoveUser_FullMethodName        = "/ticketBooking.TicketService/RemoveUser"
-	TicketService_ModifyUserSeat_FullMethodName    = "/ticketBooking.TicketService/ModifyUserSeat"
+	TicketService_PurchaseTicket_FullMethodName      = "/ticketBooking.TicketService/PurchaseTicket"
+	TicketService_GetReceipt_FullMethodName          = "/ticketBooking.TicketService/GetReceipt"
+	TicketService_GetUsersBySection_FullMethodName   = "/ticketBooking.TicketService/GetUsersBySection"
+	TicketService_RemoveUser_FullMethodName          = "/ticketBooking.TicketService/RemoveUser"
+	TicketService_ModifyUserSeat_FullMethodName      = "/ticketBooking.TicketService/ModifyUserSeat"
+	TicketService_ListDepartures_FullMethodName      = "/ticketBooking.TicketService/ListDepartures"
+	TicketService_ListBookingsByEmail_FullMethodName = "/ticketBooking.TicketService/ListBookingsByEmail"
+	TicketService_PurchaseGroupTicket_FullMethodName = "/ticketBooking.TicketService/PurchaseGroupTicket"
+	TicketService_HoldSeats_FullMethodName           = "/ticketBooking.TicketService/HoldSeats"
+	TicketService_ConfirmHold_FullMethodName         = "/ticketBooking.TicketService/ConfirmHold"
+	TicketService_ReleaseHold_FullMethodName         = "/ticketBooking.TicketService/ReleaseHold"
+	TicketService_JoinWaitlist_FullMethodName        = "/ticketBooking.TicketService/JoinWaitlist"
+	TicketService_GetWaitlistPosition_FullMethodName = "/ticketBooking.TicketService/GetWaitlistPosition"
+	TicketService_LeaveWaitlist_FullMethodName       = "/ticketBooking.TicketService/LeaveWaitlist"
+	TicketService_GetSeatMap_FullMethodName          = "/ticketBooking.TicketService/GetSeatMap"
+	TicketService_GetPriceQuote_FullMethodName       = "/ticketBooking.TicketService/GetPriceQuote"
+	TicketService_GetRefund_FullMethodName           = "/ticketBooking.TicketService/GetRefund"
+	TicketService_ListRefunds_FullMethodName         = "/ticketBooking.TicketService/ListRefunds"
+	TicketService_WatchAvailability_FullMethodName   = "/ticketBooking.TicketService/WatchAvailability"
 )
 
 // TicketServiceClient is the client API for TicketService service.
@@ -37,6 +51,20 @@ type TicketServiceClient interface {
 	GetUsersBySection(ctx context.Context, in *GetUsersBySectionRequest, opts ...grpc.CallOption) (*UsersBySectionResponse, error)
 	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*RemoveUserResponse, error)
 	ModifyUserSeat(ctx context.Context, in *ModifyUserSeatRequest, opts ...grpc.CallOption) (*TicketReceipt, error)
+	ListDepartures(ctx context.Context, in *ListDeparturesRequest, opts ...grpc.CallOption) (*ListDeparturesResponse, error)
+	ListBookingsByEmail(ctx context.Context, in *ListBookingsByEmailRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error)
+	PurchaseGroupTicket(ctx context.Context, in *PurchaseGroupTicketRequest, opts ...grpc.CallOption) (*TicketReceipt, error)
+	HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*SeatHold, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*TicketReceipt, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*SeatMap, error)
+	GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*PriceQuote, error)
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*RefundRecord, error)
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	WatchAvailability(ctx context.Context, in *WatchAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AvailabilityUpdate], error)
 }
 
 type ticketServiceClient struct {
@@ -97,6 +125,155 @@ func (c *ticketServiceClient) ModifyUserSeat(ctx context.Context, in *ModifyUser
 	return out, nil
 }
 
+func (c *ticketServiceClient) ListDepartures(ctx context.Context, in *ListDeparturesRequest, opts ...grpc.CallOption) (*ListDeparturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeparturesResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListDepartures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListBookingsByEmail(ctx context.Context, in *ListBookingsByEmailRequest, opts ...grpc.CallOption) (*ListBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListBookingsByEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) PurchaseGroupTicket(ctx context.Context, in *PurchaseGroupTicketRequest, opts ...grpc.CallOption) (*TicketReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketReceipt)
+	err := c.cc.Invoke(ctx, TicketService_PurchaseGroupTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) HoldSeats(ctx context.Context, in *HoldSeatsRequest, opts ...grpc.CallOption) (*SeatHold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatHold)
+	err := c.cc.Invoke(ctx, TicketService_HoldSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*TicketReceipt, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TicketReceipt)
+	err := c.cc.Invoke(ctx, TicketService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, TicketService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, TicketService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, TicketService_GetWaitlistPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, TicketService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*SeatMap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatMap)
+	err := c.cc.Invoke(ctx, TicketService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetPriceQuote(ctx context.Context, in *GetPriceQuoteRequest, opts ...grpc.CallOption) (*PriceQuote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceQuote)
+	err := c.cc.Invoke(ctx, TicketService_GetPriceQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*RefundRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundRecord)
+	err := c.cc.Invoke(ctx, TicketService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, TicketService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ticketServiceClient) WatchAvailability(ctx context.Context, in *WatchAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AvailabilityUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TicketService_ServiceDesc.Streams[0], TicketService_WatchAvailability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAvailabilityRequest, AvailabilityUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchAvailabilityClient = grpc.ServerStreamingClient[AvailabilityUpdate]
+
 // TicketServiceServer is the server API for TicketService service.
 // All implementations must embed UnimplementedTicketServiceServer
 // for forward compatibility.
@@ -108,6 +285,20 @@ type TicketServiceServer interface {
 	GetUsersBySection(context.Context, *GetUsersBySectionRequest) (*UsersBySectionResponse, error)
 	RemoveUser(context.Context, *RemoveUserRequest) (*RemoveUserResponse, error)
 	ModifyUserSeat(context.Context, *ModifyUserSeatRequest) (*TicketReceipt, error)
+	ListDepartures(context.Context, *ListDeparturesRequest) (*ListDeparturesResponse, error)
+	ListBookingsByEmail(context.Context, *ListBookingsByEmailRequest) (*ListBookingsResponse, error)
+	PurchaseGroupTicket(context.Context, *PurchaseGroupTicketRequest) (*TicketReceipt, error)
+	HoldSeats(context.Context, *HoldSeatsRequest) (*SeatHold, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*TicketReceipt, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntry, error)
+	GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*WaitlistEntry, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*SeatMap, error)
+	GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*PriceQuote, error)
+	GetRefund(context.Context, *GetRefundRequest) (*RefundRecord, error)
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	WatchAvailability(*WatchAvailabilityRequest, grpc.ServerStreamingServer[AvailabilityUpdate]) error
 	mustEmbedUnimplementedTicketServiceServer()
 }
 
@@ -133,6 +324,48 @@ func (UnimplementedTicketServiceServer) RemoveUser(context.Context, *RemoveUserR
 func (UnimplementedTicketServiceServer) ModifyUserSeat(context.Context, *ModifyUserSeatRequest) (*TicketReceipt, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifyUserSeat not implemented")
 }
+func (UnimplementedTicketServiceServer) ListDepartures(context.Context, *ListDeparturesRequest) (*ListDeparturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDepartures not implemented")
+}
+func (UnimplementedTicketServiceServer) ListBookingsByEmail(context.Context, *ListBookingsByEmailRequest) (*ListBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookingsByEmail not implemented")
+}
+func (UnimplementedTicketServiceServer) PurchaseGroupTicket(context.Context, *PurchaseGroupTicketRequest) (*TicketReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseGroupTicket not implemented")
+}
+func (UnimplementedTicketServiceServer) HoldSeats(context.Context, *HoldSeatsRequest) (*SeatHold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeats not implemented")
+}
+func (UnimplementedTicketServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*TicketReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedTicketServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedTicketServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedTicketServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedTicketServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*SeatMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedTicketServiceServer) GetPriceQuote(context.Context, *GetPriceQuoteRequest) (*PriceQuote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceQuote not implemented")
+}
+func (UnimplementedTicketServiceServer) GetRefund(context.Context, *GetRefundRequest) (*RefundRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedTicketServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedTicketServiceServer) WatchAvailability(*WatchAvailabilityRequest, grpc.ServerStreamingServer[AvailabilityUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAvailability not implemented")
+}
 func (UnimplementedTicketServiceServer) mustEmbedUnimplementedTicketServiceServer() {}
 func (UnimplementedTicketServiceServer) testEmbeddedByValue()                       {}
 
@@ -244,32 +477,787 @@ func _TicketService_ModifyUserSeat_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TicketService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ticketBooking.TicketService",
-	HandlerType: (*TicketServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PurchaseTicket",
-			Handler:    _TicketService_PurchaseTicket_Handler,
-		},
-		{
-			MethodName: "GetReceipt",
-			Handler:    _TicketService_GetReceipt_Handler,
-		},
-		{
-			MethodName: "GetUsersBySection",
-			Handler:    _TicketService_GetUsersBySection_Handler,
-		},
-		{
-			MethodName: "RemoveUser",
-			Handler:    _TicketService_RemoveUser_Handler,
-		},
-		{
-			MethodName: "ModifyUserSeat",
-			Handler:    _TicketService_ModifyUserSeat_Handler,
+func _TicketService_ListDepartures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeparturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListDepartures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListDepartures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListDepartures(ctx, req.(*ListDeparturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListBookingsByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListBookingsByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListBookingsByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListBookingsByEmail(ctx, req.(*ListBookingsByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_PurchaseGroupTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseGroupTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).PurchaseGroupTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_PurchaseGroupTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).PurchaseGroupTicket(ctx, req.(*PurchaseGroupTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_HoldSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).HoldSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_HoldSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).HoldSeats(ctx, req.(*HoldSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetWaitlistPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetWaitlistPosition(ctx, req.(*GetWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetPriceQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetPriceQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetPriceQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetPriceQuote(ctx, req.(*GetPriceQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TicketServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TicketService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TicketServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TicketService_WatchAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TicketServiceServer).WatchAvailability(m, &grpc.GenericServerStream[WatchAvailabilityRequest, AvailabilityUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TicketService_WatchAvailabilityServer = grpc.ServerStreamingServer[AvailabilityUpdate]
+
+// TicketService_ServiceDesc is the grpc.ServiceDesc for TicketService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TicketService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticketBooking.TicketService",
+	HandlerType: (*TicketServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurchaseTicket",
+			Handler:    _TicketService_PurchaseTicket_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _TicketService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetUsersBySection",
+			Handler:    _TicketService_GetUsersBySection_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _TicketService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "ModifyUserSeat",
+			Handler:    _TicketService_ModifyUserSeat_Handler,
+		},
+		{
+			MethodName: "ListDepartures",
+			Handler:    _TicketService_ListDepartures_Handler,
+		},
+		{
+			MethodName: "ListBookingsByEmail",
+			Handler:    _TicketService_ListBookingsByEmail_Handler,
+		},
+		{
+			MethodName: "PurchaseGroupTicket",
+			Handler:    _TicketService_PurchaseGroupTicket_Handler,
+		},
+		{
+			MethodName: "HoldSeats",
+			Handler:    _TicketService_HoldSeats_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _TicketService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _TicketService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _TicketService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _TicketService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _TicketService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _TicketService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "GetPriceQuote",
+			Handler:    _TicketService_GetPriceQuote_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _TicketService_GetRefund_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _TicketService_ListRefunds_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAvailability",
+			Handler:       _TicketService_WatchAvailability_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/ticketBooking.proto",
+}
+
+const (
+	AdminService_AddSection_FullMethodName          = "/ticketBooking.AdminService/AddSection"
+	AdminService_ResizeSection_FullMethodName       = "/ticketBooking.AdminService/ResizeSection"
+	AdminService_BlockSeat_FullMethodName           = "/ticketBooking.AdminService/BlockSeat"
+	AdminService_UnblockSeat_FullMethodName         = "/ticketBooking.AdminService/UnblockSeat"
+	AdminService_CloseSection_FullMethodName        = "/ticketBooking.AdminService/CloseSection"
+	AdminService_ReopenSection_FullMethodName       = "/ticketBooking.AdminService/ReopenSection"
+	AdminService_CreatePromoCode_FullMethodName     = "/ticketBooking.AdminService/CreatePromoCode"
+	AdminService_ListPromoCodes_FullMethodName      = "/ticketBooking.AdminService/ListPromoCodes"
+	AdminService_DisablePromoCode_FullMethodName    = "/ticketBooking.AdminService/DisablePromoCode"
+	AdminService_SetWaitlistPriority_FullMethodName = "/ticketBooking.AdminService/SetWaitlistPriority"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Service definition for managing sections, seats, promo codes and waitlist
+// priorities while bookings are taken. Changes apply at once, and all but
+// waitlist priorities are kept across restarts.
+type AdminServiceClient interface {
+	AddSection(ctx context.Context, in *AddSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error)
+	ResizeSection(ctx context.Context, in *ResizeSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error)
+	BlockSeat(ctx context.Context, in *BlockSeatRequest, opts ...grpc.CallOption) (*SeatAvailability, error)
+	UnblockSeat(ctx context.Context, in *UnblockSeatRequest, opts ...grpc.CallOption) (*SeatAvailability, error)
+	CloseSection(ctx context.Context, in *CloseSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error)
+	ReopenSection(ctx context.Context, in *ReopenSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error)
+	CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	SetWaitlistPriority(ctx context.Context, in *SetWaitlistPriorityRequest, opts ...grpc.CallOption) (*WaitlistEntry, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) AddSection(ctx context.Context, in *AddSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionInfo)
+	err := c.cc.Invoke(ctx, AdminService_AddSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ResizeSection(ctx context.Context, in *ResizeSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionInfo)
+	err := c.cc.Invoke(ctx, AdminService_ResizeSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BlockSeat(ctx context.Context, in *BlockSeatRequest, opts ...grpc.CallOption) (*SeatAvailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatAvailability)
+	err := c.cc.Invoke(ctx, AdminService_BlockSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnblockSeat(ctx context.Context, in *UnblockSeatRequest, opts ...grpc.CallOption) (*SeatAvailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeatAvailability)
+	err := c.cc.Invoke(ctx, AdminService_UnblockSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CloseSection(ctx context.Context, in *CloseSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionInfo)
+	err := c.cc.Invoke(ctx, AdminService_CloseSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReopenSection(ctx context.Context, in *ReopenSectionRequest, opts ...grpc.CallOption) (*SectionInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SectionInfo)
+	err := c.cc.Invoke(ctx, AdminService_ReopenSection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreatePromoCode(ctx context.Context, in *PromoCode, opts ...grpc.CallOption) (*PromoCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, AdminService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListPromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DisablePromoCode(ctx context.Context, in *DisablePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, AdminService_DisablePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetWaitlistPriority(ctx context.Context, in *SetWaitlistPriorityRequest, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, AdminService_SetWaitlistPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Service definition for managing sections, seats, promo codes and waitlist
+// priorities while bookings are taken. Changes apply at once, and all but
+// waitlist priorities are kept across restarts.
+type AdminServiceServer interface {
+	AddSection(context.Context, *AddSectionRequest) (*SectionInfo, error)
+	ResizeSection(context.Context, *ResizeSectionRequest) (*SectionInfo, error)
+	BlockSeat(context.Context, *BlockSeatRequest) (*SeatAvailability, error)
+	UnblockSeat(context.Context, *UnblockSeatRequest) (*SeatAvailability, error)
+	CloseSection(context.Context, *CloseSectionRequest) (*SectionInfo, error)
+	ReopenSection(context.Context, *ReopenSectionRequest) (*SectionInfo, error)
+	CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error)
+	ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error)
+	DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*PromoCode, error)
+	SetWaitlistPriority(context.Context, *SetWaitlistPriorityRequest) (*WaitlistEntry, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) AddSection(context.Context, *AddSectionRequest) (*SectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSection not implemented")
+}
+func (UnimplementedAdminServiceServer) ResizeSection(context.Context, *ResizeSectionRequest) (*SectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeSection not implemented")
+}
+func (UnimplementedAdminServiceServer) BlockSeat(context.Context, *BlockSeatRequest) (*SeatAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeat not implemented")
+}
+func (UnimplementedAdminServiceServer) UnblockSeat(context.Context, *UnblockSeatRequest) (*SeatAvailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeat not implemented")
+}
+func (UnimplementedAdminServiceServer) CloseSection(context.Context, *CloseSectionRequest) (*SectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSection not implemented")
+}
+func (UnimplementedAdminServiceServer) ReopenSection(context.Context, *ReopenSectionRequest) (*SectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenSection not implemented")
+}
+func (UnimplementedAdminServiceServer) CreatePromoCode(context.Context, *PromoCode) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedAdminServiceServer) ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedAdminServiceServer) DisablePromoCode(context.Context, *DisablePromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromoCode not implemented")
+}
+func (UnimplementedAdminServiceServer) SetWaitlistPriority(context.Context, *SetWaitlistPriorityRequest) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWaitlistPriority not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_AddSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddSection(ctx, req.(*AddSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ResizeSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ResizeSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ResizeSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ResizeSection(ctx, req.(*ResizeSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BlockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BlockSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BlockSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BlockSeat(ctx, req.(*BlockSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnblockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnblockSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnblockSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnblockSeat(ctx, req.(*UnblockSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CloseSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CloseSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CloseSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CloseSection(ctx, req.(*CloseSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReopenSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenSectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReopenSection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReopenSection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReopenSection(ctx, req.(*ReopenSectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreatePromoCode(ctx, req.(*PromoCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListPromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListPromoCodes(ctx, req.(*ListPromoCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DisablePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DisablePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DisablePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DisablePromoCode(ctx, req.(*DisablePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetWaitlistPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWaitlistPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetWaitlistPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetWaitlistPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetWaitlistPriority(ctx, req.(*SetWaitlistPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ticketBooking.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSection",
+			Handler:    _AdminService_AddSection_Handler,
+		},
+		{
+			MethodName: "ResizeSection",
+			Handler:    _AdminService_ResizeSection_Handler,
+		},
+		{
+			MethodName: "BlockSeat",
+			Handler:    _AdminService_BlockSeat_Handler,
+		},
+		{
+			MethodName: "UnblockSeat",
+			Handler:    _AdminService_UnblockSeat_Handler,
+		},
+		{
+			MethodName: "CloseSection",
+			Handler:    _AdminService_CloseSection_Handler,
+		},
+		{
+			MethodName: "ReopenSection",
+			Handler:    _AdminService_ReopenSection_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _AdminService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _AdminService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "DisablePromoCode",
+			Handler:    _AdminService_DisablePromoCode_Handler,
+		},
+		{
+			MethodName: "SetWaitlistPriority",
+			Handler:    _AdminService_SetWaitlistPriority_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
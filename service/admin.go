@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errSectionNotFound = errors.New("section not found")
+	// errSeatUnavailable refuses a change to seats that are not in the state
+	// it needs, such as removing a booked seat.
+	errSeatUnavailable = errors.New("seat unavailable")
+	errInvalidSection  = errors.New("invalid section")
+)
+
+// AddSection adds a section to the departure. Its seats can be booked at
+// once, and a config reload or restart keeps it.
+func (s *SeatManager) AddSection(config SectionConfigs) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if config.SectionName == "" || config.seatCount() <= 0 {
+		return fmt.Errorf("%w: a section needs a name and seats", errInvalidSection)
+	}
+	if config.Layout != nil {
+		if err := config.Layout.validate(); err != nil {
+			return fmt.Errorf("%w: %v", errInvalidSection, err)
+		}
+	}
+	if _, ok := s.Sections[config.SectionName]; ok {
+		return fmt.Errorf("%w: section %s already exists", errInvalidSection, config.SectionName)
+	}
+
+	section := newSection(config, max(len(s.Stops)-1, 1))
+	section.administered = true
+	if err := s.commitSection(config.SectionName, section.state(), nil); err != nil {
+		return err
+	}
+	s.Sections[config.SectionName] = section
+	s.nextSections = append(s.nextSections, config.SectionName)
+	s.publish(pb.SeatEventKind_SEAT_EVENT_KIND_ADDED, "", "", seatRange(config.SectionName, 1, section.MaxSeats))
+	return nil
+}
+
+// ResizeSection changes the number of seats of a section without a coach
+// layout, adding or removing seats at the end. Seats that are held or
+// assigned on any segment are never removed; blocked seats may be. A config
+// reload or restart keeps the new size.
+func (s *SeatManager) ResizeSection(name string, maxSeats int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	section, ok := s.Sections[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", errSectionNotFound, name)
+	}
+	if section.Layout != nil {
+		return fmt.Errorf("%w: section %s has a coach layout", errInvalidSection, name)
+	}
+	if maxSeats <= 0 {
+		return fmt.Errorf("%w: a section needs seats", errInvalidSection)
+	}
+	if maxSeats == section.MaxSeats {
+		return nil
+	}
+
+	// Seats past the end are stored as available so that none is left
+	// behind in the store to be restored into a later resize.
+	segments := max(len(s.Stops)-1, 1)
+	available := initializeSeats(1, segments)[1]
+	changes := make(map[int][]string)
+	first, last := section.MaxSeats+1, maxSeats
+	if maxSeats < section.MaxSeats {
+		first, last = maxSeats+1, section.MaxSeats
+		if taken := takenSeats(section, first, last); len(taken) > 0 {
+			return fmt.Errorf("%w: seats %v of section %s are taken", errSeatUnavailable, taken, name)
+		}
+	}
+	for seat := first; seat <= last; seat++ {
+		changes[seat] = available
+	}
+	state := section.state()
+	state.MaxSeats = maxSeats
+	state.Administered = true
+	for seat := maxSeats + 1; seat <= section.MaxSeats; seat++ {
+		delete(state.SeatFeatures, seat)
+	}
+	if err := s.commitSection(name, state, changes); err != nil {
+		return err
+	}
+
+	kind := pb.SeatEventKind_SEAT_EVENT_KIND_ADDED
+	if maxSeats < section.MaxSeats {
+		kind = pb.SeatEventKind_SEAT_EVENT_KIND_REMOVED
+		for seat := first; seat <= last; seat++ {
+			delete(section.Occupancy, seat)
+			delete(section.Features, seat)
+		}
+	} else {
+		for seat := first; seat <= last; seat++ {
+			section.Occupancy[seat] = append([]string(nil), available...)
+			if len(section.features) > 0 {
+				section.Features[seat] = append([]SeatFeature(nil), section.features...)
+			}
+		}
+	}
+	section.MaxSeats = maxSeats
+	section.administered = true
+	s.publish(kind, "", "", seatRange(name, first, last))
+	return nil
+}
+
+// BlockSeat takes a seat that is available on the whole route out of sale
+// until it is unblocked, and returns its new state.
+func (s *SeatManager) BlockSeat(section string, seat int) (*pb.SeatAvailability, error) {
+	return s.setBlocked(section, seat, "Available", "Blocked", pb.SeatEventKind_SEAT_EVENT_KIND_BLOCKED)
+}
+
+// UnblockSeat puts a blocked seat back on sale and returns its new state.
+func (s *SeatManager) UnblockSeat(section string, seat int) (*pb.SeatAvailability, error) {
+	return s.setBlocked(section, seat, "Blocked", "Available", pb.SeatEventKind_SEAT_EVENT_KIND_UNBLOCKED)
+}
+
+// setBlocked moves a seat that is in state from on every segment into state
+// to.
+func (s *SeatManager) setBlocked(name string, seat int, from, to string, kind pb.SeatEventKind) (*pb.SeatAvailability, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	section, ok := s.Sections[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errSectionNotFound, name)
+	}
+	segments, ok := section.Occupancy[seat]
+	if !ok {
+		return nil, fmt.Errorf("%w: seat %d not in section %s", errSectionNotFound, seat, name)
+	}
+	if !segmentsIn(segments, 0, len(segments), from) {
+		return nil, fmt.Errorf("%w: seat %d in section %s is not %s", errSeatUnavailable, seat, name, from)
+	}
+
+	updated := withSegments(segments, 0, len(segments), to)
+	if err := s.commitSeats(name, map[int][]string{seat: updated}); err != nil {
+		return nil, err
+	}
+	section.Occupancy[seat] = updated
+	s.publish(kind, "", "", []SeatAssignment{{Seat: seat, Section: name}})
+	return s.seatAvailability(name, seat), nil
+}
+
+// SetSectionClosed closes a section to new bookings, or reopens it. A
+// restart keeps the section as it is.
+func (s *SeatManager) SetSectionClosed(name string, closed bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	section, ok := s.Sections[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", errSectionNotFound, name)
+	}
+	state := section.state()
+	state.Closed = closed
+	if err := s.commitSection(name, state, nil); err != nil {
+		return err
+	}
+	section.Closed = closed
+	return nil
+}
+
+// state returns the section as it is stored.
+func (sec *Section) state() *SectionState {
+	state := &SectionState{
+		MaxSeats:     sec.MaxSeats,
+		Layout:       sec.Layout,
+		FareClass:    sec.FareClass,
+		Features:     sec.features,
+		SeatFeatures: sec.Features,
+		Closed:       sec.Closed,
+		Administered: sec.administered,
+	}
+	return state.clone()
+}
+
+// commitSection stores the state of a section together with changes to its
+// seats. Callers must hold s.mu.
+func (s *SeatManager) commitSection(name string, state *SectionState, seats map[int][]string) error {
+	key := departureStoreKey(s.departureID, name)
+	mutation := &Mutation{Sections: map[string]*SectionState{key: state}}
+	if len(seats) > 0 {
+		mutation.Seats = map[string]map[int][]string{key: seats}
+	}
+	if err := s.store.Commit(mutation); err != nil {
+		return fmt.Errorf("persist section change: %w", err)
+	}
+	return nil
+}
+
+// restoreSections restores the sections changed with AdminService, keyed as
+// by departureStoreKey, before their seats are restored. Administered
+// sections replace those of the config, and those the config lacks follow the
+// others in name order; other sections only keep whether they are closed.
+// Entries for other departures and for sections the config no longer has are
+// ignored.
+func (s *SeatManager) restoreSections(saved map[string]*SectionState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := max(len(s.Stops)-1, 1)
+	for _, key := range slices.Sorted(maps.Keys(saved)) {
+		name, ok := sectionFromStoreKey(s.departureID, key)
+		if !ok {
+			continue
+		}
+		state := saved[key]
+		section, ok := s.Sections[name]
+		if state.Administered {
+			restored := newSection(SectionConfigs{
+				SectionName:  name,
+				MaxSeats:     state.MaxSeats,
+				Layout:       state.Layout,
+				Features:     state.Features,
+				SeatFeatures: state.SeatFeatures,
+				FareClass:    state.FareClass,
+			}, segments)
+			restored.administered = true
+			if !ok {
+				s.nextSections = append(s.nextSections, name)
+			}
+			s.Sections[name] = restored
+			section, ok = restored, true
+		}
+		if ok {
+			section.Closed = state.Closed
+		}
+	}
+}
+
+// SectionInfo describes a section.
+func (s *SeatManager) SectionInfo(name string) (*pb.SectionInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	section, ok := s.Sections[name]
+	if !ok {
+		return nil, false
+	}
+	return sectionToProto(section), true
+}
+
+// seatRange returns the seats first to last of a section.
+func seatRange(section string, first, last int) []SeatAssignment {
+	seats := []SeatAssignment{}
+	for seat := first; seat <= last; seat++ {
+		seats = append(seats, SeatAssignment{Seat: seat, Section: section})
+	}
+	return seats
+}
+
+// takenSeats returns the seats first to last of a section that are assigned or
+// held on any segment.
+func takenSeats(section *Section, first, last int) []int {
+	taken := []int{}
+	for seat := first; seat <= last; seat++ {
+		if state := section.SeatState(seat); state == "Assigned" || state == "Held" {
+			taken = append(taken, seat)
+		}
+	}
+	return taken
+}
+
+// AdminServer implements AdminService on the departures of a TicketManager.
+// Its changes apply to seat inventory while bookings are taken, and are kept
+// across restarts.
+type AdminServer struct {
+	pb.UnimplementedAdminServiceServer
+	tickets *TicketManager
+}
+
+// NewAdminServer returns an AdminServer managing the departures of tickets.
+func NewAdminServer(tickets *TicketManager) *AdminServer {
+	return &AdminServer{tickets: tickets}
+}
+
+// adminStatus converts an inventory change error to a gRPC status.
+func adminStatus(err error) error {
+	switch {
+	case errors.Is(err, errSectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errSeatUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, errInvalidSection):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to save inventory change")
+	}
+}
+
+// seatManager returns the seats of a departure. Callers must hold t.mu.
+func (a *AdminServer) seatManager(departureID string) (*SeatManager, error) {
+	departure, ok := a.tickets.departure(departureID)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+	return departure.SeatManager, nil
+}
+
+// sectionInfo describes a section after a change. Callers must hold t.mu.
+func (a *AdminServer) sectionInfo(seatManager *SeatManager, name string) (*pb.SectionInfo, error) {
+	info, ok := seatManager.SectionInfo(name)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "section not found")
+	}
+	return info, nil
+}
+
+// AddSection adds a section to a departure. Its fare class must be standard
+// or have a fare table. Waiting passengers who now fit are booked into it.
+func (a *AdminServer) AddSection(ctx context.Context, req *pb.AddSectionRequest) (*pb.SectionInfo, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("AddSection request received: %+v", req)
+
+	features, err := seatFeaturesFromProto(req.Features)
+	if err != nil {
+		log.Printf("AddSection request with invalid features: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("AddSection request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+	seatManager, err := a.seatManager(req.DepartureId)
+	if err != nil {
+		log.Printf("AddSection request with unknown departure: %s", req.DepartureId)
+		return nil, err
+	}
+
+	if err := seatManager.AddSection(SectionConfigs{
+		SectionName: req.Section,
+		MaxSeats:    int(req.MaxSeats),
+		FareClass:   req.FareClass,
+		Features:    features,
+	}); err != nil {
+		log.Printf("AddSection failed: %v", err)
+		return nil, adminStatus(err)
+	}
+	t.promoteWaitlists()
+
+	log.Printf("AddSection successful: departure=%s, section=%s", req.DepartureId, req.Section)
+	return a.sectionInfo(seatManager, req.Section)
+}
+
+// ResizeSection changes the number of seats of a section. It refuses to
+// remove seats that are held or assigned.
+func (a *AdminServer) ResizeSection(ctx context.Context, req *pb.ResizeSectionRequest) (*pb.SectionInfo, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ResizeSection request received: %+v", req)
+
+	seatManager, err := a.seatManager(req.DepartureId)
+	if err != nil {
+		log.Printf("ResizeSection request with unknown departure: %s", req.DepartureId)
+		return nil, err
+	}
+
+	if err := seatManager.ResizeSection(req.Section, int(req.MaxSeats)); err != nil {
+		log.Printf("ResizeSection failed: %v", err)
+		return nil, adminStatus(err)
+	}
+	t.promoteWaitlists()
+
+	log.Printf("ResizeSection successful: departure=%s, section=%s, seats=%d", req.DepartureId, req.Section, req.MaxSeats)
+	return a.sectionInfo(seatManager, req.Section)
+}
+
+// BlockSeat takes an available seat out of sale, such as for maintenance.
+func (a *AdminServer) BlockSeat(ctx context.Context, req *pb.BlockSeatRequest) (*pb.SeatAvailability, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("BlockSeat request received: %+v", req)
+
+	seatManager, err := a.seatManager(req.DepartureId)
+	if err != nil {
+		log.Printf("BlockSeat request with unknown departure: %s", req.DepartureId)
+		return nil, err
+	}
+
+	seat, err := seatManager.BlockSeat(req.Section, int(req.SeatNumber))
+	if err != nil {
+		log.Printf("BlockSeat failed: %v", err)
+		return nil, adminStatus(err)
+	}
+
+	log.Printf("BlockSeat successful: %+v", seat)
+	return seat, nil
+}
+
+// UnblockSeat puts a blocked seat back on sale. Waiting passengers who now
+// fit are booked.
+func (a *AdminServer) UnblockSeat(ctx context.Context, req *pb.UnblockSeatRequest) (*pb.SeatAvailability, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("UnblockSeat request received: %+v", req)
+
+	seatManager, err := a.seatManager(req.DepartureId)
+	if err != nil {
+		log.Printf("UnblockSeat request with unknown departure: %s", req.DepartureId)
+		return nil, err
+	}
+
+	seat, err := seatManager.UnblockSeat(req.Section, int(req.SeatNumber))
+	if err != nil {
+		log.Printf("UnblockSeat failed: %v", err)
+		return nil, adminStatus(err)
+	}
+	t.promoteWaitlists()
+
+	log.Printf("UnblockSeat successful: %+v", seat)
+	return seat, nil
+}
+
+// CloseSection stops a section taking new bookings.
+func (a *AdminServer) CloseSection(ctx context.Context, req *pb.CloseSectionRequest) (*pb.SectionInfo, error) {
+	return a.setSectionClosed("CloseSection", req.DepartureId, req.Section, true)
+}
+
+// ReopenSection lets a closed section take bookings again. Waiting passengers
+// who now fit are booked.
+func (a *AdminServer) ReopenSection(ctx context.Context, req *pb.ReopenSectionRequest) (*pb.SectionInfo, error) {
+	return a.setSectionClosed("ReopenSection", req.DepartureId, req.Section, false)
+}
+
+// setSectionClosed closes or reopens a section for the named request.
+func (a *AdminServer) setSectionClosed(request, departureID, section string, closed bool) (*pb.SectionInfo, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("%s request received: departure=%s, section=%s", request, departureID, section)
+
+	seatManager, err := a.seatManager(departureID)
+	if err != nil {
+		log.Printf("%s request with unknown departure: %s", request, departureID)
+		return nil, err
+	}
+
+	if err := seatManager.SetSectionClosed(section, closed); err != nil {
+		log.Printf("%s failed: %v", request, err)
+		return nil, adminStatus(err)
+	}
+	if !closed {
+		t.promoteWaitlists()
+	}
+
+	log.Printf("%s successful: departure=%s, section=%s", request, departureID, section)
+	return a.sectionInfo(seatManager, section)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bookLondonParis books n London-Paris tickets for numbered passengers.
+func bookLondonParis(t *testing.T, tm *TicketManager, n int) []*pb.TicketReceipt {
+	t.Helper()
+
+	receipts := []*pb.TicketReceipt{}
+	for i := 0; i < n; i++ {
+		receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{FirstName: fmt.Sprintf("Passenger%d", i), Email: fmt.Sprintf("p%d@example.com", i)}, From: "London", To: "Paris",
+		})
+		require.NoError(t, err)
+		receipts = append(receipts, receipt)
+	}
+	return receipts
+}
+
+func TestAdminAddSection(t *testing.T) {
+	tm := createPassengerTicketManager()
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+	bookLondonParis(t, tm, 4)
+
+	entry, err := tm.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris",
+	})
+	require.NoError(t, err)
+
+	info, err := admin.AddSection(ctx, &pb.AddSectionRequest{
+		Section: "B", MaxSeats: 2, Features: []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_QUIET_ZONE},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &pb.SectionInfo{Name: "B", MaxSeats: 2, FareClass: FareClassStandard}, info)
+	assert.Equal(t, []SeatFeature{FeatureQuietZone}, tm.SeatManager.SeatFeatures("B", 2))
+
+	promoted, err := tm.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{WaitlistId: entry.WaitlistId})
+	require.NoError(t, err)
+	assert.NotEmpty(t, promoted.BookingReference, "Waiting passenger should be seated in the new section")
+
+	departures, err := tm.ListDepartures(ctx, &pb.ListDeparturesRequest{})
+	require.NoError(t, err)
+	require.Len(t, departures.Departures[0].Sections, 2)
+	assert.Equal(t, "B", departures.Departures[0].Sections[1].Name)
+
+	tests := []struct {
+		name         string
+		request      *pb.AddSectionRequest
+		expectedCode codes.Code
+	}{
+		{name: "Existing section", request: &pb.AddSectionRequest{Section: "A", MaxSeats: 2}, expectedCode: codes.InvalidArgument},
+		{name: "No seats", request: &pb.AddSectionRequest{Section: "C"}, expectedCode: codes.InvalidArgument},
+		{name: "No name", request: &pb.AddSectionRequest{MaxSeats: 2}, expectedCode: codes.InvalidArgument},
+		{name: "Unknown feature", request: &pb.AddSectionRequest{Section: "C", MaxSeats: 2, Features: []pb.SeatFeature{99}}, expectedCode: codes.InvalidArgument},
+		{name: "Unknown departure", request: &pb.AddSectionRequest{DepartureId: "nope", Section: "C", MaxSeats: 2}, expectedCode: codes.NotFound},
+		{name: "Unknown fare class", request: &pb.AddSectionRequest{Section: "C", MaxSeats: 2, FareClass: "premium"}, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := admin.AddSection(ctx, tt.request)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestAdminResizeSection(t *testing.T) {
+	tests := []struct {
+		name          string
+		section       string
+		maxSeats      int32
+		expectedCode  codes.Code
+		expectedSeats int
+	}{
+		{name: "Grow", section: "A", maxSeats: 6, expectedSeats: 6},
+		{name: "Shrink past free seats", section: "A", maxSeats: 2, expectedSeats: 2},
+		{name: "Shrink past a booked seat", section: "A", maxSeats: 1, expectedCode: codes.FailedPrecondition, expectedSeats: 4},
+		{name: "No seats", section: "A", maxSeats: 0, expectedCode: codes.InvalidArgument, expectedSeats: 4},
+		{name: "Coach layout", section: "L", maxSeats: 4, expectedCode: codes.InvalidArgument, expectedSeats: 4},
+		{name: "Unknown section", section: "Z", maxSeats: 4, expectedCode: codes.NotFound, expectedSeats: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sections := []SectionConfigs{{SectionName: "A", MaxSeats: 4}, {SectionName: "L", Layout: &testLayout}}
+			store := NewMemoryStore()
+			tm := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+			fill, err := NewAllocationStrategy(FillSectionFirst)
+			require.NoError(t, err)
+			tm.SetAllocationStrategy("", fill)
+			bookLondonParis(t, tm, 2)
+
+			info, err := NewAdminServer(tm).ResizeSection(context.Background(), &pb.ResizeSectionRequest{Section: tt.section, MaxSeats: tt.maxSeats})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if err == nil {
+				assert.Equal(t, tt.maxSeats, info.MaxSeats)
+			}
+
+			section := tm.SeatManager.Sections["A"]
+			assert.Equal(t, tt.expectedSeats, section.MaxSeats)
+			assert.Len(t, section.Occupancy, tt.expectedSeats)
+			_, capacity, err := tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSeats+testLayout.SeatCount(), capacity)
+		})
+	}
+}
+
+func TestAdminBlockSeat(t *testing.T) {
+	store := NewMemoryStore()
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 2}}
+	tm := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+	_, watcher, err := tm.SeatManager.watch("A", "", 0)
+	require.NoError(t, err)
+	defer tm.SeatManager.unwatch(watcher)
+
+	seat, err := admin.BlockSeat(ctx, &pb.BlockSeatRequest{Section: "A", SeatNumber: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Blocked", seat.State)
+	assert.Equal(t, pb.SeatEventKind_SEAT_EVENT_KIND_BLOCKED, (<-watcher.updates).GetEvent().GetKind())
+
+	occupied, capacity, err := tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+	require.NoError(t, err)
+	assert.Equal(t, 0, occupied, "Blocked seats are not occupied")
+	assert.Equal(t, 2, capacity)
+
+	receipts := bookLondonParis(t, tm, 1)
+	assert.Equal(t, int32(2), receipts[0].Seat.SeatNumber, "Blocked seats are not sold")
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{Email: "bo@example.com"}, From: "London", To: "Paris"})
+	assert.Error(t, err)
+	_, err = tm.ModifyUserSeat(ctx, &pb.ModifyUserSeatRequest{
+		BookingReference: receipts[0].BookingReference, NewSeat: &pb.Seat{Section: "A", SeatNumber: 1},
+	})
+	assert.Error(t, err, "Blocked seats cannot be moved into")
+
+	_, err = admin.BlockSeat(ctx, &pb.BlockSeatRequest{Section: "A", SeatNumber: 2})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Booked seats cannot be blocked")
+	_, err = admin.BlockSeat(ctx, &pb.BlockSeatRequest{Section: "A", SeatNumber: 3})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = admin.UnblockSeat(ctx, &pb.UnblockSeatRequest{Section: "A", SeatNumber: 2})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Blocked seats stay blocked after a restart.
+	restarted := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	require.NoError(t, restarted.Restore())
+	assert.Equal(t, "Blocked", restarted.SeatManager.Sections["A"].SeatState(1))
+
+	seat, err = NewAdminServer(restarted).UnblockSeat(ctx, &pb.UnblockSeatRequest{Section: "A", SeatNumber: 1})
+	require.NoError(t, err)
+	assert.Equal(t, "Available", seat.State)
+	_, err = restarted.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{Email: "bo@example.com"}, From: "London", To: "Paris"})
+	assert.NoError(t, err)
+}
+
+func TestAdminCloseSection(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+
+	info, err := admin.CloseSection(ctx, &pb.CloseSectionRequest{Section: "F"})
+	require.NoError(t, err)
+	assert.True(t, info.Closed)
+
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "Paris", FareClass: FareClassFirst,
+	})
+	assert.Error(t, err, "A closed section takes no bookings")
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{Email: "bo@example.com"}, From: "London", To: "Paris"})
+	require.NoError(t, err)
+	_, err = tm.ModifyUserSeat(ctx, &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "F", SeatNumber: 1},
+	})
+	assert.Error(t, err)
+
+	departures, err := tm.ListDepartures(ctx, &pb.ListDeparturesRequest{})
+	require.NoError(t, err)
+	assert.True(t, departures.Departures[0].Sections[0].Closed)
+
+	info, err = admin.ReopenSection(ctx, &pb.ReopenSectionRequest{Section: "F"})
+	require.NoError(t, err)
+	assert.False(t, info.Closed)
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "Paris", FareClass: FareClassFirst,
+	})
+	assert.NoError(t, err)
+
+	_, err = admin.CloseSection(ctx, &pb.CloseSectionRequest{Section: "Z"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAdminChangesSurviveRestart(t *testing.T) {
+	store, err := NewWALStore(t.TempDir(), 100)
+	require.NoError(t, err)
+	defer store.Close()
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 2}, {SectionName: "B", MaxSeats: 2}}
+	tm := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+
+	_, err = admin.AddSection(ctx, &pb.AddSectionRequest{
+		Section: "C", MaxSeats: 2, Features: []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_QUIET_ZONE},
+	})
+	require.NoError(t, err)
+	_, err = admin.ResizeSection(ctx, &pb.ResizeSectionRequest{Section: "B", MaxSeats: 3})
+	require.NoError(t, err)
+	_, err = admin.CloseSection(ctx, &pb.CloseSectionRequest{Section: "A"})
+	require.NoError(t, err)
+	receipts := bookLondonParis(t, tm, 4)
+	assert.Equal(t, "C", receipts[3].Seat.Section)
+
+	restarted := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	require.NoError(t, restarted.Restore())
+	departures, err := restarted.ListDepartures(ctx, &pb.ListDeparturesRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.SectionInfo{
+		{Name: "A", MaxSeats: 2, FareClass: FareClassStandard, Closed: true},
+		{Name: "B", MaxSeats: 3, FareClass: FareClassStandard},
+		{Name: "C", MaxSeats: 2, FareClass: FareClassStandard},
+	}, departures.Departures[0].Sections)
+	assert.Equal(t, "Assigned", restarted.SeatManager.Sections["C"].SeatState(int(receipts[3].Seat.SeatNumber)))
+	assert.Equal(t, []SeatFeature{FeatureQuietZone}, restarted.SeatManager.SeatFeatures("C", 2))
+
+	_, err = NewAdminServer(restarted).ReopenSection(ctx, &pb.ReopenSectionRequest{Section: "A"})
+	require.NoError(t, err)
+	reopened := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	require.NoError(t, reopened.Restore())
+	assert.False(t, reopened.SeatManager.Sections["A"].Closed)
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AllocationStrategy decides which free seats a booking gets. Strategies must
+// be deterministic: the same view and count always give the same seats.
+type AllocationStrategy interface {
+	// Name identifies the strategy, as accepted by NewAllocationStrategy.
+	Name() string
+	// Allocate picks count seats from the free seats in view, or returns nil
+	// when there are not enough.
+	Allocate(view AllocationView, count int) []SeatAssignment
+}
+
+// AllocationView describes the free seats of a departure for one journey.
+type AllocationView struct {
+	// Sections lists every section in configuration order.
+	Sections []SectionView
+	// Next is the index in Sections that a round-robin strategy starts from.
+	Next int
+}
+
+// SectionView describes one section's free seats for a journey.
+type SectionView struct {
+	Name     string
+	MaxSeats int
+	// Occupied counts the seats held or assigned on some part of the journey.
+	Occupied int
+	// Free lists the seats on offer for the whole journey, ascending. It may
+	// leave out free seats that do not match the passenger's requirements.
+	Free []int
+}
+
+// Names of the built-in allocation strategies.
+const (
+	LowestNumberFirst      = "lowest-number-first"
+	BalanceByOccupancy     = "balance-by-occupancy"
+	RoundRobinWithFallback = "round-robin-with-fallback"
+	FillSectionFirst       = "fill-section-first"
+)
+
+// NewAllocationStrategy returns the built-in strategy with the given name.
+func NewAllocationStrategy(name string) (AllocationStrategy, error) {
+	switch name {
+	case LowestNumberFirst:
+		return lowestNumberFirst{}, nil
+	case BalanceByOccupancy:
+		return balanceByOccupancy{}, nil
+	case RoundRobinWithFallback:
+		return roundRobinWithFallback{}, nil
+	case FillSectionFirst:
+		return fillSectionFirst{}, nil
+	default:
+		return nil, fmt.Errorf("unknown allocation strategy %q", name)
+	}
+}
+
+// lowestNumberFirst hands out the lowest free seat number in any section,
+// preferring earlier sections between equal numbers.
+type lowestNumberFirst struct{}
+
+func (lowestNumberFirst) Name() string { return LowestNumberFirst }
+
+func (lowestNumberFirst) Allocate(view AllocationView, count int) []SeatAssignment {
+	order := append([]SectionView(nil), view.Sections...)
+	sort.SliceStable(order, func(i, j int) bool {
+		return firstFree(order[i]) < firstFree(order[j])
+	})
+	return chooseGroupSeats(order, count)
+}
+
+// balanceByOccupancy books into the section with the smallest share of its
+// seats taken, preferring earlier sections between equal shares.
+type balanceByOccupancy struct{}
+
+func (balanceByOccupancy) Name() string { return BalanceByOccupancy }
+
+func (balanceByOccupancy) Allocate(view AllocationView, count int) []SeatAssignment {
+	order := append([]SectionView(nil), view.Sections...)
+	sort.SliceStable(order, func(i, j int) bool {
+		// Compare occupied_i/max_i < occupied_j/max_j without division.
+		return order[i].Occupied*order[j].MaxSeats < order[j].Occupied*order[i].MaxSeats
+	})
+	return chooseGroupSeats(order, count)
+}
+
+// roundRobinWithFallback rotates through the sections, starting from the one
+// after the section last booked and moving on when a section is full.
+type roundRobinWithFallback struct{}
+
+func (roundRobinWithFallback) Name() string { return RoundRobinWithFallback }
+
+func (roundRobinWithFallback) Allocate(view AllocationView, count int) []SeatAssignment {
+	order := make([]SectionView, 0, len(view.Sections))
+	for i := range view.Sections {
+		order = append(order, view.Sections[(view.Next+i)%len(view.Sections)])
+	}
+	return chooseGroupSeats(order, count)
+}
+
+// fillSectionFirst fills sections one at a time in configuration order.
+type fillSectionFirst struct{}
+
+func (fillSectionFirst) Name() string { return FillSectionFirst }
+
+func (fillSectionFirst) Allocate(view AllocationView, count int) []SeatAssignment {
+	return chooseGroupSeats(view.Sections, count)
+}
+
+// validateAllocation checks that a strategy chose distinct seats that are free
+// in view.
+func validateAllocation(view AllocationView, chosen []SeatAssignment) error {
+	free := make(map[SeatAssignment]bool)
+	for _, section := range view.Sections {
+		for _, seat := range section.Free {
+			free[SeatAssignment{Seat: seat, Section: section.Name}] = true
+		}
+	}
+	for _, assignment := range chosen {
+		if !free[assignment] {
+			return fmt.Errorf("seat %d in section %s is not available", assignment.Seat, assignment.Section)
+		}
+		// Each seat can only be handed out once.
+		free[assignment] = false
+	}
+	return nil
+}
+
+// firstFree returns the lowest free seat of a section, or the largest int when
+// it is full so that full sections sort last.
+func firstFree(section SectionView) int {
+	if len(section.Free) == 0 {
+		return int(^uint(0) >> 1)
+	}
+	return section.Free[0]
+}
+
+// chooseGroupSeats picks count seats from sections tried in order, or returns
+// nil when there are not enough. It prefers a run of consecutive seat numbers
+// in one section, then any seats within one section, and only then splits the
+// group across sections.
+func chooseGroupSeats(order []SectionView, count int) []SeatAssignment {
+	// A run of consecutive seat numbers in one section.
+	for _, section := range order {
+		seats := section.Free
+		for i := 0; i+count <= len(seats); i++ {
+			if seats[i+count-1]-seats[i] == count-1 {
+				return seatAssignments(section.Name, seats[i:i+count])
+			}
+		}
+	}
+
+	// Any seats within one section.
+	for _, section := range order {
+		if len(section.Free) >= count {
+			return seatAssignments(section.Name, section.Free[:count])
+		}
+	}
+
+	// Split across sections.
+	chosen := []SeatAssignment{}
+	for _, section := range order {
+		for _, seat := range section.Free {
+			chosen = append(chosen, SeatAssignment{Seat: seat, Section: section.Name})
+			if len(chosen) == count {
+				return chosen
+			}
+		}
+	}
+	return nil
+}
+
+// seatAssignments pairs seat numbers with their section.
+func seatAssignments(section string, seats []int) []SeatAssignment {
+	assignments := make([]SeatAssignment, len(seats))
+	for i, seat := range seats {
+		assignments[i] = SeatAssignment{Seat: seat, Section: section}
+	}
+	return assignments
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocationStrategies(t *testing.T) {
+	sectionConfigs := []SectionConfigs{
+		{SectionName: "A", MaxSeats: 4},
+		{SectionName: "B", MaxSeats: 2},
+	}
+
+	tests := []struct {
+		strategy string
+		// taken lists seats assigned before the bookings under test.
+		taken    []SeatAssignment
+		expected []SeatAssignment
+	}{
+		{
+			strategy: LowestNumberFirst,
+			taken:    []SeatAssignment{{Seat: 1, Section: "A"}},
+			expected: []SeatAssignment{{Seat: 1, Section: "B"}, {Seat: 2, Section: "A"}, {Seat: 2, Section: "B"}, {Seat: 3, Section: "A"}},
+		},
+		{
+			strategy: BalanceByOccupancy,
+			expected: []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 1, Section: "B"}, {Seat: 2, Section: "A"}, {Seat: 3, Section: "A"}},
+		},
+		{
+			strategy: RoundRobinWithFallback,
+			taken:    []SeatAssignment{{Seat: 1, Section: "B"}, {Seat: 2, Section: "B"}},
+			expected: []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 2, Section: "A"}, {Seat: 3, Section: "A"}, {Seat: 4, Section: "A"}},
+		},
+		{
+			strategy: FillSectionFirst,
+			expected: []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 2, Section: "A"}, {Seat: 3, Section: "A"}, {Seat: 4, Section: "A"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.strategy, func(t *testing.T) {
+			strategy, err := NewAllocationStrategy(tc.strategy)
+			require.NoError(t, err)
+			assert.Equal(t, tc.strategy, strategy.Name())
+
+			// Run twice to check that the results do not vary between runs.
+			for run := 0; run < 2; run++ {
+				seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+				seatManager.SetStrategy(strategy)
+				for _, taken := range tc.taken {
+					seatManager.Sections[taken.Section].Occupancy[taken.Seat] = []string{"Assigned"}
+				}
+
+				got := []SeatAssignment{}
+				for range tc.expected {
+					seat, section, err := seatManager.AssignSeat("London", "France")
+					require.NoError(t, err)
+					got = append(got, SeatAssignment{Seat: seat, Section: section})
+				}
+				assert.Equal(t, tc.expected, got)
+			}
+		})
+	}
+
+	t.Run("Unknown strategy", func(t *testing.T) {
+		_, err := NewAllocationStrategy("random")
+		assert.Error(t, err)
+	})
+}
+
+func TestRoundRobinRotates(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{
+		{SectionName: "A", MaxSeats: 2},
+		{SectionName: "B", MaxSeats: 2},
+	}, NewMemoryStore())
+
+	expected := []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 1, Section: "B"}, {Seat: 2, Section: "A"}, {Seat: 2, Section: "B"}}
+	for _, want := range expected {
+		seat, section, err := seatManager.AssignSeat("London", "France")
+		require.NoError(t, err)
+		assert.Equal(t, want, SeatAssignment{Seat: seat, Section: section})
+	}
+
+	_, _, err := seatManager.AssignSeat("London", "France")
+	assert.EqualError(t, err, "no seats available")
+}
+
+func TestBalanceByOccupancyIgnoresBlockedSeats(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{
+		{SectionName: "A", MaxSeats: 4},
+		{SectionName: "B", MaxSeats: 2},
+	}, NewMemoryStore())
+	strategy, err := NewAllocationStrategy(BalanceByOccupancy)
+	require.NoError(t, err)
+	seatManager.SetStrategy(strategy)
+	seatManager.Sections["A"].Occupancy[1] = []string{"Blocked"}
+	seatManager.Sections["A"].Occupancy[2] = []string{"Blocked"}
+
+	seat, section, err := seatManager.AssignSeat("London", "France")
+	require.NoError(t, err)
+	assert.Equal(t, SeatAssignment{Seat: 3, Section: "A"}, SeatAssignment{Seat: seat, Section: section}, "Blocked seats are not occupied")
+}
+
+// badStrategy hands out a seat whether or not it is free.
+type badStrategy struct{}
+
+func (badStrategy) Name() string { return "bad" }
+
+func (badStrategy) Allocate(view AllocationView, count int) []SeatAssignment {
+	return []SeatAssignment{{Seat: 1, Section: "A"}}
+}
+
+func TestAllocationIsValidated(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 2}}, NewMemoryStore())
+	seatManager.SetStrategy(badStrategy{})
+
+	_, _, err := seatManager.AssignSeat("London", "France")
+	require.NoError(t, err)
+
+	_, _, err = seatManager.AssignSeat("London", "France")
+	assert.Error(t, err, "Seat 1 is already taken")
+}
+
+func TestSetAllocationStrategyPerTrain(t *testing.T) {
+	tm := createTestTicketManager()
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 2}, {SectionName: "B", MaxSeats: 2}}
+
+	early, err := tm.AddDeparture(DepartureConfig{ID: "T1-0800", TrainID: "T1", DepartureTime: time.Now(), Sections: sections})
+	require.NoError(t, err)
+
+	fill, err := NewAllocationStrategy(FillSectionFirst)
+	require.NoError(t, err)
+	tm.SetAllocationStrategy("T1", fill)
+
+	late, err := tm.AddDeparture(DepartureConfig{ID: "T1-1800", TrainID: "T1", DepartureTime: time.Now(), Sections: sections})
+	require.NoError(t, err)
+	other, err := tm.AddDeparture(DepartureConfig{ID: "T2-0900", TrainID: "T2", DepartureTime: time.Now(), Sections: sections})
+	require.NoError(t, err)
+
+	assert.Equal(t, FillSectionFirst, early.SeatManager.Strategy().Name())
+	assert.Equal(t, FillSectionFirst, late.SeatManager.Strategy().Name(), "Departures added later use the train's strategy")
+	assert.Equal(t, RoundRobinWithFallback, other.SeatManager.Strategy().Name())
+	assert.Equal(t, RoundRobinWithFallback, tm.SeatManager.Strategy().Name())
+}
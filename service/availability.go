@@ -0,0 +1,244 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// availabilityHistory is the number of recent seat changes kept per
+	// departure for watchers resuming after a reconnect.
+	availabilityHistory = 1024
+	// watcherBuffer is the number of updates a watcher may fall behind by
+	// before its stream is ended.
+	watcherBuffer = 256
+)
+
+// availabilityFeed numbers the seat changes of a SeatManager and fans them out
+// to watchers. Its fields are guarded by the SeatManager's mu.
+type availabilityFeed struct {
+	// epoch names this numbering of changes; it differs after a restart.
+	epoch    string
+	sequence uint64
+	// history holds the most recent changes, oldest first.
+	history  []*pb.AvailabilityUpdate
+	watchers map[*availabilityWatcher]bool
+}
+
+// availabilityWatcher receives the changes of one section, or of every
+// section when section is empty. Its channel is closed if it falls behind.
+type availabilityWatcher struct {
+	section string
+	updates chan *pb.AvailabilityUpdate
+}
+
+// newAvailabilityFeed starts a feed with a fresh epoch.
+func newAvailabilityFeed() *availabilityFeed {
+	return &availabilityFeed{
+		epoch:    strconv.FormatInt(time.Now().UnixNano(), 36),
+		watchers: make(map[*availabilityWatcher]bool),
+	}
+}
+
+// seatAvailability describes the current state of a seat. Callers must hold
+// s.mu.
+func (s *SeatManager) seatAvailability(section string, seat int) *pb.SeatAvailability {
+	sec := s.Sections[section]
+	return &pb.SeatAvailability{
+		Section:    section,
+		SeatNumber: int32(seat),
+		State:      sec.SeatState(seat),
+		Segments:   append([]string(nil), sec.Occupancy[seat]...),
+	}
+}
+
+// publish numbers a change to the given seats, which already hold their new
+// states, and sends it to every watcher of a section it touches. A watcher
+// that has fallen too far behind is dropped. Callers must hold s.mu.
+func (s *SeatManager) publish(kind pb.SeatEventKind, from, to string, seats []SeatAssignment) {
+	feed := s.feed
+	feed.sequence++
+
+	event := &pb.SeatEvent{Kind: kind, From: from, To: to}
+	for _, seat := range seats {
+		event.Seats = append(event.Seats, s.seatAvailability(seat.Section, seat.Seat))
+	}
+	update := &pb.AvailabilityUpdate{
+		Epoch:    feed.epoch,
+		Sequence: feed.sequence,
+		Update:   &pb.AvailabilityUpdate_Event{Event: event},
+	}
+
+	feed.history = append(feed.history, update)
+	if len(feed.history) > availabilityHistory {
+		feed.history = feed.history[len(feed.history)-availabilityHistory:]
+	}
+
+	for watcher := range feed.watchers {
+		filtered := filterUpdate(update, watcher.section)
+		if filtered == nil {
+			continue
+		}
+		select {
+		case watcher.updates <- filtered:
+		default:
+			log.Printf("Availability watcher of departure %s fell behind at sequence %d", s.departureID, feed.sequence)
+			close(watcher.updates)
+			delete(feed.watchers, watcher)
+		}
+	}
+}
+
+// filterUpdate returns an update holding only the seats of one section, the
+// update itself when section is empty, or nil when it touches no seat of the
+// section.
+func filterUpdate(update *pb.AvailabilityUpdate, section string) *pb.AvailabilityUpdate {
+	if section == "" {
+		return update
+	}
+	event := update.GetEvent()
+	filtered := &pb.SeatEvent{Kind: event.Kind, From: event.From, To: event.To}
+	for _, seat := range event.Seats {
+		if seat.Section == section {
+			filtered.Seats = append(filtered.Seats, seat)
+		}
+	}
+	if len(filtered.Seats) == 0 {
+		return nil
+	}
+	return &pb.AvailabilityUpdate{
+		Epoch:    update.Epoch,
+		Sequence: update.Sequence,
+		Update:   &pb.AvailabilityUpdate_Event{Event: filtered},
+	}
+}
+
+// watch registers a watcher of one section, or of every section when section
+// is empty, and returns the updates to send it first: the changes after
+// resumeAfter when epoch is the feed's and they are all still in the history,
+// otherwise a snapshot of the current seat states.
+func (s *SeatManager) watch(section, epoch string, resumeAfter uint64) ([]*pb.AvailabilityUpdate, *availabilityWatcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.Sections[section]; section != "" && !ok {
+		return nil, nil, fmt.Errorf("section %s not found", section)
+	}
+
+	feed := s.feed
+	watcher := &availabilityWatcher{section: section, updates: make(chan *pb.AvailabilityUpdate, watcherBuffer)}
+	feed.watchers[watcher] = true
+
+	if initial, ok := feed.replay(section, epoch, resumeAfter); ok {
+		return initial, watcher, nil
+	}
+	return []*pb.AvailabilityUpdate{s.snapshot(section)}, watcher, nil
+}
+
+// replay returns the changes to a section after resumeAfter, or false when
+// they cannot all be replayed. Callers must hold the SeatManager's mu.
+func (f *availabilityFeed) replay(section, epoch string, resumeAfter uint64) ([]*pb.AvailabilityUpdate, bool) {
+	if epoch != f.epoch || resumeAfter > f.sequence {
+		return nil, false
+	}
+	// The history runs up to f.sequence, so it covers every change after
+	// resumeAfter when it reaches back to the change following it.
+	if missed := f.sequence - resumeAfter; missed > uint64(len(f.history)) {
+		return nil, false
+	}
+
+	updates := []*pb.AvailabilityUpdate{}
+	for _, update := range f.history {
+		if update.Sequence <= resumeAfter {
+			continue
+		}
+		if filtered := filterUpdate(update, section); filtered != nil {
+			updates = append(updates, filtered)
+		}
+	}
+	return updates, true
+}
+
+// snapshot describes every seat of one section, or of every section in
+// configuration order when section is empty. Callers must hold s.mu.
+func (s *SeatManager) snapshot(section string) *pb.AvailabilityUpdate {
+	snapshot := &pb.AvailabilitySnapshot{DepartureId: s.departureID, Stops: s.Stops}
+	for _, name := range s.nextSections {
+		if section != "" && name != section {
+			continue
+		}
+		for seat := 1; seat <= s.Sections[name].MaxSeats; seat++ {
+			if _, ok := s.Sections[name].Occupancy[seat]; ok {
+				snapshot.Seats = append(snapshot.Seats, s.seatAvailability(name, seat))
+			}
+		}
+	}
+	return &pb.AvailabilityUpdate{
+		Epoch:    s.feed.epoch,
+		Sequence: s.feed.sequence,
+		Update:   &pb.AvailabilityUpdate_Snapshot{Snapshot: snapshot},
+	}
+}
+
+// unwatch removes a watcher.
+func (s *SeatManager) unwatch(watcher *availabilityWatcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.feed.watchers[watcher] {
+		close(watcher.updates)
+		delete(s.feed.watchers, watcher)
+	}
+}
+
+// WatchAvailability streams the seat availability of a departure: a snapshot
+// of every seat first, then every change as it happens. A client reconnecting
+// with the epoch and sequence number of the last update it received resumes
+// from there. A client that falls too far behind is disconnected with
+// RESOURCE_EXHAUSTED and can resume the same way.
+func (t *TicketManager) WatchAvailability(req *pb.WatchAvailabilityRequest, stream pb.TicketService_WatchAvailabilityServer) error {
+	log.Printf("WatchAvailability request received: %+v", req)
+
+	t.mu.Lock()
+	departure, ok := t.departure(req.DepartureId)
+	t.mu.Unlock()
+	if !ok {
+		log.Printf("WatchAvailability request with unknown departure: %s", req.DepartureId)
+		return status.Error(codes.NotFound, "departure not found")
+	}
+
+	seatManager := departure.SeatManager
+	initial, watcher, err := seatManager.watch(req.Section, req.Epoch, req.ResumeAfter)
+	if err != nil {
+		log.Printf("WatchAvailability failed: %v", err)
+		return status.Error(codes.NotFound, err.Error())
+	}
+	defer seatManager.unwatch(watcher)
+
+	for _, update := range initial {
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			log.Printf("WatchAvailability ended: %v", stream.Context().Err())
+			return nil
+		case update, ok := <-watcher.updates:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell behind; reconnect to resume")
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
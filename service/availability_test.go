@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// availabilityStream collects the updates of a WatchAvailability call.
+type availabilityStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *pb.AvailabilityUpdate
+}
+
+func (s *availabilityStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *availabilityStream) Send(update *pb.AvailabilityUpdate) error {
+	s.updates <- update
+	return nil
+}
+
+// watchAvailability starts a WatchAvailability call and returns its stream, a
+// function ending the call and a channel receiving the call's result.
+func watchAvailability(tm *TicketManager, req *pb.WatchAvailabilityRequest) (*availabilityStream, context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &availabilityStream{ctx: ctx, updates: make(chan *pb.AvailabilityUpdate, 100)}
+	done := make(chan error, 1)
+	go func() {
+		done <- tm.WatchAvailability(req, stream)
+	}()
+	return stream, cancel, done
+}
+
+// nextUpdate waits for the next update of a stream.
+func nextUpdate(t *testing.T, stream *availabilityStream) *pb.AvailabilityUpdate {
+	t.Helper()
+	select {
+	case update := <-stream.updates:
+		return update
+	case <-time.After(time.Second):
+		require.FailNow(t, "no availability update received")
+		return nil
+	}
+}
+
+// assertNoUpdate checks that a stream has nothing more to send.
+func assertNoUpdate(t *testing.T, stream *availabilityStream) {
+	t.Helper()
+	select {
+	case update := <-stream.updates:
+		assert.Fail(t, "unexpected availability update", "%v", update)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// freeSeat returns a seat of a section that is available on the whole route.
+func freeSeat(t *testing.T, tm *TicketManager, section string) int32 {
+	for seat := 1; seat <= tm.SeatManager.Sections[section].MaxSeats; seat++ {
+		if tm.SeatManager.Sections[section].SeatState(seat) == "Available" {
+			return int32(seat)
+		}
+	}
+	require.FailNow(t, "no free seat")
+	return 0
+}
+
+func TestWatchAvailability(t *testing.T) {
+	tm := createPassengerTicketManager()
+	ctx := context.Background()
+	ada := &pb.User{FirstName: "Ada", Email: "ada@example.com"}
+
+	_, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: ada, From: "London", To: "Paris"})
+	require.NoError(t, err)
+
+	stream, cancel, done := watchAvailability(tm, &pb.WatchAvailabilityRequest{})
+	first := nextUpdate(t, stream)
+	snapshot := first.GetSnapshot()
+	require.NotNil(t, snapshot, "Stream should start with a snapshot")
+	assert.Equal(t, uint64(1), first.Sequence)
+	require.Len(t, snapshot.Seats, 4)
+	assigned := 0
+	for _, seat := range snapshot.Seats {
+		if seat.State == "Assigned" {
+			assigned++
+		}
+	}
+	assert.Equal(t, 1, assigned)
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{FirstName: "Bo", Email: "bo@example.com"}, From: "London", To: "Paris"})
+	require.NoError(t, err)
+	hold, err := tm.HoldSeats(ctx, &pb.HoldSeatsRequest{From: "London", To: "Paris", SeatCount: 1})
+	require.NoError(t, err)
+	_, err = tm.ReleaseHold(ctx, &pb.ReleaseHoldRequest{HoldId: hold.HoldId})
+	require.NoError(t, err)
+	newSeat := freeSeat(t, tm, "A")
+	_, err = tm.ModifyUserSeat(ctx, &pb.ModifyUserSeatRequest{BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "A", SeatNumber: newSeat}})
+	require.NoError(t, err)
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+
+	expected := []struct {
+		kind  pb.SeatEventKind
+		seats int
+		state string
+	}{
+		{kind: pb.SeatEventKind_SEAT_EVENT_KIND_ASSIGNED, seats: 1, state: "Assigned"},
+		{kind: pb.SeatEventKind_SEAT_EVENT_KIND_HELD, seats: 1, state: "Held"},
+		{kind: pb.SeatEventKind_SEAT_EVENT_KIND_HOLD_RELEASED, seats: 1, state: "Available"},
+		{kind: pb.SeatEventKind_SEAT_EVENT_KIND_MODIFIED, seats: 2, state: "Available"},
+		{kind: pb.SeatEventKind_SEAT_EVENT_KIND_RELEASED, seats: 1, state: "Available"},
+	}
+	for i, want := range expected {
+		update := nextUpdate(t, stream)
+		assert.Equal(t, first.Epoch, update.Epoch)
+		assert.Equal(t, first.Sequence+uint64(i+1), update.Sequence)
+		event := update.GetEvent()
+		require.NotNil(t, event)
+		assert.Equal(t, want.kind, event.Kind)
+		require.Len(t, event.Seats, want.seats)
+		assert.Equal(t, want.state, event.Seats[0].State)
+		assert.Equal(t, "London", event.From)
+	}
+	cancel()
+	assert.NoError(t, <-done)
+
+	// Resuming replays only the changes after the last one received.
+	resumed, cancel, _ := watchAvailability(tm, &pb.WatchAvailabilityRequest{Epoch: first.Epoch, ResumeAfter: first.Sequence + 3})
+	defer cancel()
+	update := nextUpdate(t, resumed)
+	assert.Equal(t, pb.SeatEventKind_SEAT_EVENT_KIND_MODIFIED, update.GetEvent().GetKind())
+	assert.Equal(t, pb.SeatEventKind_SEAT_EVENT_KIND_RELEASED, nextUpdate(t, resumed).GetEvent().GetKind())
+	assertNoUpdate(t, resumed)
+
+	// Another epoch's sequence numbers mean nothing here.
+	restarted, cancel, _ := watchAvailability(tm, &pb.WatchAvailabilityRequest{Epoch: "other", ResumeAfter: first.Sequence})
+	defer cancel()
+	assert.NotNil(t, nextUpdate(t, restarted).GetSnapshot())
+}
+
+func TestWatchAvailabilitySection(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	ctx := context.Background()
+
+	stream, cancel, _ := watchAvailability(tm, &pb.WatchAvailabilityRequest{Section: "F"})
+	defer cancel()
+	snapshot := nextUpdate(t, stream).GetSnapshot()
+	require.Len(t, snapshot.Seats, 2)
+	assert.Equal(t, "F", snapshot.Seats[0].Section)
+
+	_, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{Email: "ada@example.com"}, From: "London", To: "Paris"})
+	require.NoError(t, err)
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: &pb.User{Email: "bo@example.com"}, From: "London", To: "Paris", FareClass: FareClassFirst})
+	require.NoError(t, err)
+
+	update := nextUpdate(t, stream)
+	assert.Equal(t, uint64(2), update.Sequence, "Changes to other sections are skipped")
+	assert.Equal(t, "F", update.GetEvent().GetSeats()[0].GetSection())
+	assertNoUpdate(t, stream)
+
+	err = tm.WatchAvailability(&pb.WatchAvailabilityRequest{Section: "Z"}, &availabilityStream{ctx: ctx})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = tm.WatchAvailability(&pb.WatchAvailabilityRequest{DepartureId: "nope"}, &availabilityStream{ctx: ctx})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAvailabilityWatcherFallsBehind(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 2}}, NewMemoryStore())
+	_, watcher, err := seatManager.watch("", "", 0)
+	require.NoError(t, err)
+
+	seatManager.mu.Lock()
+	for i := 0; i <= watcherBuffer; i++ {
+		seatManager.publish(pb.SeatEventKind_SEAT_EVENT_KIND_ASSIGNED, "", "", []SeatAssignment{{Seat: 1, Section: "A"}})
+	}
+	seatManager.mu.Unlock()
+
+	received := 0
+	for range watcher.updates {
+		received++
+	}
+	assert.Equal(t, watcherBuffer, received, "Watcher should be dropped once its buffer is full")
+	seatManager.unwatch(watcher)
+}
+
+func TestAvailabilityReplay(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 2}}, NewMemoryStore())
+	seatManager.mu.Lock()
+	defer seatManager.mu.Unlock()
+	for i := 0; i < availabilityHistory+10; i++ {
+		seatManager.publish(pb.SeatEventKind_SEAT_EVENT_KIND_ASSIGNED, "", "", []SeatAssignment{{Seat: 1, Section: "A"}})
+	}
+	feed := seatManager.feed
+
+	tests := []struct {
+		name        string
+		resumeAfter uint64
+		expected    int
+		expectOK    bool
+	}{
+		{name: "Up to date", resumeAfter: feed.sequence, expected: 0, expectOK: true},
+		{name: "A few behind", resumeAfter: feed.sequence - 3, expected: 3, expectOK: true},
+		{name: "Oldest kept change", resumeAfter: feed.sequence - availabilityHistory, expected: availabilityHistory, expectOK: true},
+		{name: "Too far behind", resumeAfter: 5},
+		{name: "Ahead of the feed", resumeAfter: feed.sequence + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, ok := feed.replay("", feed.epoch, tt.resumeAfter)
+			assert.Equal(t, tt.expectOK, ok)
+			assert.Len(t, updates, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bookingReferenceAlphabet omits characters that are easily confused when read
+// aloud or handwritten (0/O, 1/I/L).
+const bookingReferenceAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// bookingReferenceLength is the number of characters in a booking reference.
+const bookingReferenceLength = 6
+
+// newBookingReference generates a random booking reference that is not yet a
+// key in any of receipts. Callers must hold the lock guarding receipts.
+func newBookingReference(receipts ...map[string]*pb.TicketReceipt) (string, error) {
+	return newReference(func(reference string) bool {
+		for _, taken := range receipts {
+			if _, ok := taken[reference]; ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// newReference generates a random code from the booking reference alphabet for
+// which taken reports false.
+func newReference(taken func(string) bool) (string, error) {
+	limit := big.NewInt(int64(len(bookingReferenceAlphabet)))
+	for attempt := 0; attempt < 10; attempt++ {
+		reference := make([]byte, bookingReferenceLength)
+		for i := range reference {
+			n, err := rand.Int(rand.Reader, limit)
+			if err != nil {
+				return "", fmt.Errorf("generate booking reference: %w", err)
+			}
+			reference[i] = bookingReferenceAlphabet[n.Int64()]
+		}
+		if !taken(string(reference)) {
+			return string(reference), nil
+		}
+	}
+	return "", fmt.Errorf("generate booking reference: too many collisions")
+}
+
+// findReceipt resolves a booking from its reference or, failing that, from the
+// email it was bought with. An email only resolves when it has exactly one
+// booking. It returns the receipt key and a gRPC status error on failure.
+// Callers must hold t.mu.
+func (t *TicketManager) findReceipt(email, reference string) (string, *pb.TicketReceipt, error) {
+	if reference != "" {
+		receipt, ok := t.Receipts[reference]
+		if !ok || (email != "" && receipt.GetUser().GetEmail() != email) {
+			return "", nil, status.Error(codes.NotFound, "ticket receipt not found")
+		}
+		return reference, receipt, nil
+	}
+
+	if email == "" {
+		return "", nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	var foundKey string
+	var found *pb.TicketReceipt
+	for key, receipt := range t.Receipts {
+		if receipt.GetUser().GetEmail() != email {
+			continue
+		}
+		if found != nil {
+			return "", nil, status.Error(codes.FailedPrecondition, "multiple bookings for email, use the booking reference")
+		}
+		foundKey, found = key, receipt
+	}
+
+	if found == nil {
+		return "", nil, status.Error(codes.NotFound, "ticket receipt not found")
+	}
+	return foundKey, found, nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewBookingReference(t *testing.T) {
+	receipts := map[string]*pb.TicketReceipt{}
+	for i := 0; i < 100; i++ {
+		reference, err := newBookingReference(receipts)
+		require.NoError(t, err)
+		assert.Len(t, reference, bookingReferenceLength)
+		assert.NotContains(t, receipts, reference)
+		receipts[reference] = &pb.TicketReceipt{}
+	}
+}
+
+func TestBookingsByReference(t *testing.T) {
+	tm := createTestTicketManager()
+	user := &pb.User{FirstName: "Nandha", LastName: "Kumar", Email: "repeat@example.com"}
+
+	first, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{User: user, From: "London", To: "France"})
+	require.NoError(t, err)
+	second, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{User: user, From: "London", To: "France"})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.BookingReference, second.BookingReference)
+	assert.Len(t, tm.Receipts, 2, "A second purchase must not overwrite the first")
+
+	t.Run("Email with several bookings is ambiguous", func(t *testing.T) {
+		_, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{Email: user.Email})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("Reference with a different email is not found", func(t *testing.T) {
+		_, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{Email: "other@example.com", BookingReference: first.BookingReference})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("List bookings by email", func(t *testing.T) {
+		resp, err := tm.ListBookingsByEmail(context.Background(), &pb.ListBookingsByEmailRequest{Email: user.Email})
+		require.NoError(t, err)
+		assert.Len(t, resp.Receipts, 2)
+
+		_, err = tm.ListBookingsByEmail(context.Background(), &pb.ListBookingsByEmailRequest{})
+		st, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Modify and remove by reference", func(t *testing.T) {
+		newSeat := &pb.Seat{SeatNumber: 50, Section: first.Seat.Section}
+		if first.Seat.SeatNumber == 50 || (second.Seat.Section == newSeat.Section && second.Seat.SeatNumber == 50) {
+			newSeat.SeatNumber = 49
+		}
+		modified, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{BookingReference: first.BookingReference, NewSeat: newSeat})
+		require.NoError(t, err)
+		assert.Equal(t, newSeat.SeatNumber, modified.Seat.SeatNumber)
+
+		_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{BookingReference: first.BookingReference})
+		require.NoError(t, err)
+		assert.NotContains(t, tm.Receipts, first.BookingReference)
+
+		// With one booking left the email is unambiguous again.
+		receipt, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{Email: user.Email})
+		require.NoError(t, err)
+		assert.Equal(t, second.BookingReference, receipt.BookingReference)
+	})
+}
+
+func TestRestoreEmailKeyedReceipts(t *testing.T) {
+	store := NewMemoryStore()
+	require.NoError(t, store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{
+			"legacy@example.com": {User: &pb.User{Email: "legacy@example.com"}, Seat: &pb.Seat{SeatNumber: 1, Section: "A"}},
+		},
+	}))
+
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 2}}, store), map[string]int64{}, store)
+	require.NoError(t, tm.Restore())
+
+	receipt, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{BookingReference: "legacy@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "legacy@example.com", receipt.BookingReference)
+}
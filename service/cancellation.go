@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CancellationBand refunds RefundPercent of the price paid, less a Fee in
+// minor units, to bookings cancelled at least MinNotice before departure.
+type CancellationBand struct {
+	MinNotice     time.Duration
+	RefundPercent float64
+	Fee           int64
+}
+
+// name describes when a band applies, such as "48 hours or more before
+// departure".
+func (b CancellationBand) name() string {
+	if b.MinNotice == 0 {
+		return "any time before departure"
+	}
+	return fmt.Sprintf("%v hours or more before departure", b.MinNotice.Hours())
+}
+
+// CancellationPolicy decides the refund of a cancelled booking by the time
+// left before departure. The band with the longest MinNotice that the
+// cancellation still gives applies. A cancellation after departure, or later
+// than every band, refunds nothing, so a policy without bands is
+// non-refundable. Departures without a departure time get the band with the
+// longest notice.
+type CancellationPolicy struct {
+	Bands []CancellationBand
+}
+
+// FullRefund refunds the whole price until departure.
+var FullRefund = CancellationPolicy{Bands: []CancellationBand{{RefundPercent: 100}}}
+
+// NonRefundable refunds nothing.
+var NonRefundable = CancellationPolicy{}
+
+// validate checks that every band refunds a percentage, charges no negative
+// fee and has a notice of its own.
+func (p CancellationPolicy) validate() error {
+	notices := make(map[time.Duration]bool)
+	for _, band := range p.Bands {
+		if band.MinNotice < 0 || notices[band.MinNotice] {
+			return fmt.Errorf("invalid or repeated cancellation band %v", band.MinNotice)
+		}
+		if band.RefundPercent < 0 || band.RefundPercent > 100 {
+			return fmt.Errorf("cancellation band %v: invalid refund %v%%", band.MinNotice, band.RefundPercent)
+		}
+		if band.Fee < 0 {
+			return fmt.Errorf("cancellation band %v: fee must not be negative", band.MinNotice)
+		}
+		notices[band.MinNotice] = true
+	}
+	return nil
+}
+
+// band returns the band that applies to a cancellation made at now, or false
+// when none does. Bands are ordered from the longest notice.
+func (p CancellationPolicy) band(departureTime, now time.Time) (CancellationBand, bool) {
+	for _, band := range p.Bands {
+		if departureTime.IsZero() || departureTime.Sub(now) >= band.MinNotice {
+			return band, true
+		}
+	}
+	return CancellationBand{}, false
+}
+
+// refund returns the refund and the fee deducted from a price paid. The fee
+// never takes the refund below zero.
+func (b CancellationBand) refund(paid int64) (int64, int64) {
+	refund := scaleMinorUnits(paid, new(big.Rat).Quo(ratio(b.RefundPercent), big.NewRat(100, 1)))
+	fee := min(b.Fee, refund)
+	return refund - fee, fee
+}
+
+// SetCancellationPolicy sets the cancellation policy of bookings made in a
+// fare class. Classes without one are fully refundable.
+func (t *TicketManager) SetCancellationPolicy(fareClass string, policy CancellationPolicy) error {
+	if err := policy.validate(); err != nil {
+		return err
+	}
+	bands := append([]CancellationBand(nil), policy.Bands...)
+	sort.Slice(bands, func(i, j int) bool { return bands[i].MinNotice > bands[j].MinNotice })
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.cancellationPolicies[fareClassOrDefault(fareClass)] = CancellationPolicy{Bands: bands}
+	return nil
+}
+
+// cancellationPolicy returns the policy of a fare class. Callers must hold
+// t.mu.
+func (t *TicketManager) cancellationPolicy(fareClass string) CancellationPolicy {
+	if policy, ok := t.cancellationPolicies[fareClassOrDefault(fareClass)]; ok {
+		return policy
+	}
+	return FullRefund
+}
+
+// newRefund works out the refund of cancelling a booking at now under the
+// policy of the fare class it was made in. A booking that was not paid for,
+// because no payment provider was set or it cost nothing, refunds nothing.
+// Callers must hold t.mu.
+func (t *TicketManager) newRefund(receipt *pb.TicketReceipt, now time.Time) (*pb.RefundRecord, error) {
+	id, err := newReference(func(id string) bool {
+		_, taken := t.refunds["RF-"+id]
+		return taken
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var departureTime time.Time
+	if legs := receiptLegs(receipt); len(legs) > 0 {
+		if departure, ok := t.departure(legs[0].DepartureId); ok {
+			departureTime = departure.DepartureTime
+		}
+	}
+
+	paid := receipt.Price.GetMinorUnits()
+	record := &pb.RefundRecord{
+		RefundId:         "RF-" + id,
+		BookingReference: receipt.BookingReference,
+		Email:            receipt.GetUser().GetEmail(),
+		Paid:             newMoney(t.Currency, paid),
+		Amount:           newMoney(t.Currency, 0),
+		CancellationFee:  newMoney(t.Currency, 0),
+		FareClass:        fareClassOrDefault(receipt.FareClass),
+		Rule:             "no refund",
+		PaymentId:        receipt.PaymentId,
+		CancelledAt:      timestamppb.New(now),
+	}
+	if receipt.PaymentId == "" {
+		record.Paid = newMoney(t.Currency, 0)
+		record.Rule = "nothing paid"
+		return record, nil
+	}
+	if band, ok := t.cancellationPolicy(receipt.FareClass).band(departureTime, now); ok {
+		amount, fee := band.refund(paid)
+		record.Amount = newMoney(t.Currency, amount)
+		record.CancellationFee = newMoney(t.Currency, fee)
+		record.RefundPercent = band.RefundPercent
+		record.Rule = band.name()
+	}
+	record.RefundPending = t.Payments != nil && record.PaymentId != "" && record.Amount.GetMinorUnits() > 0
+	return record, nil
+}
+
+// payRefund pays a pending refund back to the booking's payment and saves it
+// as paid. The refund must already be saved as pending, so that it is never
+// paid for a cancellation that was not saved, and a refund the provider
+// refuses stays pending. t.mu is released while the provider is called.
+// Callers must hold t.mu.
+func (t *TicketManager) payRefund(ctx context.Context, refund *pb.RefundRecord) error {
+	if !refund.RefundPending || t.Payments == nil {
+		return nil
+	}
+	var err error
+	t.unlocked(func() {
+		err = t.Payments.Refund(ctx, refund.PaymentId, refund.Amount)
+	})
+	if err != nil {
+		return err
+	}
+	refund.RefundPending = false
+	if err := t.store.Commit(&Mutation{Refunds: map[string]*pb.RefundRecord{refund.RefundId: refund}}); err != nil {
+		return fmt.Errorf("refund %s was paid but could not be saved as paid: %w", refund.RefundId, err)
+	}
+	return nil
+}
+
+// GetRefund returns a refund by its ID.
+func (t *TicketManager) GetRefund(ctx context.Context, req *pb.GetRefundRequest) (*pb.RefundRecord, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("GetRefund request received: %+v", req)
+
+	refund, ok := t.refunds[req.RefundId]
+	if !ok {
+		log.Printf("GetRefund refund not found: %s", req.RefundId)
+		return nil, status.Error(codes.NotFound, "refund not found")
+	}
+	return proto.Clone(refund).(*pb.RefundRecord), nil
+}
+
+// ListRefunds returns the refunds of a booking email, a booking reference or
+// both, oldest first.
+func (t *TicketManager) ListRefunds(ctx context.Context, req *pb.ListRefundsRequest) (*pb.ListRefundsResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ListRefunds request received: %+v", req)
+
+	response := &pb.ListRefundsResponse{Refunds: []*pb.RefundRecord{}}
+	for _, refund := range t.refunds {
+		if req.Email != "" && refund.Email != req.Email {
+			continue
+		}
+		if req.BookingReference != "" && refund.BookingReference != req.BookingReference {
+			continue
+		}
+		response.Refunds = append(response.Refunds, proto.Clone(refund).(*pb.RefundRecord))
+	}
+	sort.Slice(response.Refunds, func(i, j int) bool {
+		a, b := response.Refunds[i], response.Refunds[j]
+		if !a.CancelledAt.AsTime().Equal(b.CancelledAt.AsTime()) {
+			return a.CancelledAt.AsTime().Before(b.CancelledAt.AsTime())
+		}
+		return a.RefundId < b.RefundId
+	})
+
+	log.Printf("ListRefunds successful: %d refunds", len(response.Refunds))
+	return response, nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tieredPolicy refunds in full 48 hours ahead, less a 500 pence fee 2 hours
+// ahead, and half the price after that until departure.
+var tieredPolicy = CancellationPolicy{Bands: []CancellationBand{
+	{MinNotice: 2 * time.Hour, RefundPercent: 100, Fee: 500},
+	{MinNotice: 48 * time.Hour, RefundPercent: 100},
+	{RefundPercent: 50},
+}}
+
+func TestCancellationPolicyRefund(t *testing.T) {
+	require.NoError(t, tieredPolicy.validate())
+	tm := createPassengerTicketManager()
+	require.NoError(t, tm.SetCancellationPolicy("", tieredPolicy))
+	policy := tm.cancellationPolicy(FareClassStandard)
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		policy         CancellationPolicy
+		departureTime  time.Time
+		expectedRefund int64
+		expectedFee    int64
+		expectRefund   bool
+	}{
+		{name: "Full refund well ahead", policy: policy, departureTime: now.Add(72 * time.Hour), expectedRefund: 2000, expectRefund: true},
+		{name: "Fee deducted a day ahead", policy: policy, departureTime: now.Add(24 * time.Hour), expectedRefund: 1500, expectedFee: 500, expectRefund: true},
+		{name: "Partial refund at the last minute", policy: policy, departureTime: now.Add(time.Hour), expectedRefund: 1000, expectRefund: true},
+		{name: "Nothing after departure", policy: policy, departureTime: now.Add(-time.Hour)},
+		{name: "Longest notice without a departure time", policy: policy, expectedRefund: 2000, expectRefund: true},
+		{name: "Non-refundable", policy: NonRefundable, departureTime: now.Add(72 * time.Hour)},
+		{name: "Full refund policy", policy: FullRefund, departureTime: now.Add(time.Minute), expectedRefund: 2000, expectRefund: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			band, ok := tt.policy.band(tt.departureTime, now)
+			assert.Equal(t, tt.expectRefund, ok)
+			if !ok {
+				return
+			}
+			refund, fee := band.refund(2000)
+			assert.Equal(t, tt.expectedRefund, refund)
+			assert.Equal(t, tt.expectedFee, fee)
+		})
+	}
+
+	// The fee never takes the refund below zero.
+	refund, fee := CancellationBand{RefundPercent: 100, Fee: 500}.refund(300)
+	assert.Equal(t, int64(0), refund)
+	assert.Equal(t, int64(300), fee)
+}
+
+func TestCancellationPolicyValidation(t *testing.T) {
+	tm := createPassengerTicketManager()
+
+	assert.Error(t, tm.SetCancellationPolicy("", CancellationPolicy{Bands: []CancellationBand{{RefundPercent: 120}}}))
+	assert.Error(t, tm.SetCancellationPolicy("", CancellationPolicy{Bands: []CancellationBand{{RefundPercent: 100, Fee: -1}}}))
+	assert.Error(t, tm.SetCancellationPolicy("", CancellationPolicy{Bands: []CancellationBand{{MinNotice: -time.Hour, RefundPercent: 100}}}))
+	assert.Error(t, tm.SetCancellationPolicy("", CancellationPolicy{Bands: []CancellationBand{{RefundPercent: 100}, {RefundPercent: 50}}}))
+	assert.NoError(t, tm.SetCancellationPolicy(FareClassFirst, NonRefundable))
+}
+
+func TestRemoveUserRefund(t *testing.T) {
+	tm := createPassengerTicketManager()
+	provider := NewFakePaymentProvider()
+	tm.Payments = provider
+	require.NoError(t, tm.SetCancellationPolicy(FareClassStandard, tieredPolicy))
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID: "LP-TOMORROW", DepartureTime: time.Now().Add(24 * time.Hour), Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 4}},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", DepartureId: "LP-TOMORROW", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+
+	response, err := tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	refund := response.Refund
+	assert.Equal(t, int64(2000), refund.Paid.GetMinorUnits())
+	assert.Equal(t, int64(1500), refund.Amount.GetMinorUnits())
+	assert.Equal(t, int64(500), refund.CancellationFee.GetMinorUnits())
+	assert.Equal(t, "2 hours or more before departure", refund.Rule)
+	assert.Equal(t, receipt.PaymentId, refund.PaymentId)
+	assert.Equal(t, receipt.BookingReference, refund.BookingReference)
+
+	payment, ok := provider.Payment(receipt.PaymentId)
+	require.True(t, ok)
+	assert.Equal(t, int64(1500), payment.Refunded, "Refund should be paid back to the card")
+	assert.False(t, refund.RefundPending, "A paid refund is no longer pending")
+
+	fetched, err := tm.GetRefund(ctx, &pb.GetRefundRequest{RefundId: refund.RefundId})
+	require.NoError(t, err)
+	assert.Equal(t, refund.RefundId, fetched.RefundId)
+	_, err = tm.GetRefund(ctx, &pb.GetRefundRequest{RefundId: "RF-NOPE"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Bookings on the default departure have no departure time.
+	second, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+	response, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: second.BookingReference})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), response.Refund.Amount.GetMinorUnits())
+
+	listed, err := tm.ListRefunds(ctx, &pb.ListRefundsRequest{Email: "ada@example.com"})
+	require.NoError(t, err)
+	require.Len(t, listed.Refunds, 2)
+	assert.Equal(t, refund.RefundId, listed.Refunds[0].RefundId)
+	listed, err = tm.ListRefunds(ctx, &pb.ListRefundsRequest{BookingReference: second.BookingReference})
+	require.NoError(t, err)
+	require.Len(t, listed.Refunds, 1)
+	listed, err = tm.ListRefunds(ctx, &pb.ListRefundsRequest{Email: "bo@example.com"})
+	require.NoError(t, err)
+	assert.Empty(t, listed.Refunds)
+}
+
+func TestRemoveUserRefundFails(t *testing.T) {
+	tm := createPassengerTicketManager()
+	provider := NewFakePaymentProvider()
+	tm.Payments = provider
+	ctx := context.Background()
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+	// Refunded outside the service, so nothing is left to refund.
+	require.NoError(t, provider.Refund(ctx, receipt.PaymentId, receipt.Price))
+
+	response, err := tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	assert.NotContains(t, tm.Receipts, receipt.BookingReference, "The cancellation is saved before the refund is paid")
+	assert.True(t, response.Refund.RefundPending, "A refused refund stays pending")
+	state, err := tm.store.Load()
+	require.NoError(t, err)
+	assert.True(t, state.Refunds[response.Refund.RefundId].RefundPending)
+}
+
+func TestRemoveUserUnpaidRefundsNothing(t *testing.T) {
+	tests := []struct {
+		name       string
+		payments   PaymentProvider
+		promoCodes []string
+	}{
+		{name: "No payment provider"},
+		{name: "Free booking", payments: NewFakePaymentProvider(), promoCodes: []string{"FREE"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPassengerTicketManager()
+			tm.Payments = tt.payments
+			require.NoError(t, tm.AddPromoCode(PromoCode{Code: "FREE", Percent: 100}))
+			ctx := context.Background()
+
+			receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+				User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris",
+				PromoCodes: tt.promoCodes, PaymentToken: "tok_visa",
+			})
+			require.NoError(t, err)
+			require.Empty(t, receipt.PaymentId)
+
+			response, err := tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+			require.NoError(t, err)
+			assert.Zero(t, response.Refund.Paid.GetMinorUnits())
+			assert.Zero(t, response.Refund.Amount.GetMinorUnits())
+			assert.Equal(t, "nothing paid", response.Refund.Rule)
+			assert.False(t, response.Refund.RefundPending)
+		})
+	}
+}
+
+func TestRemoveUserNotSavedRefundsNothing(t *testing.T) {
+	tm := createPassengerTicketManager()
+	provider := NewFakePaymentProvider()
+	tm.Payments = provider
+	ctx := context.Background()
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+	tm.store = failingStore{}
+
+	for range 2 {
+		_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	}
+	assert.Contains(t, tm.Receipts, receipt.BookingReference, "Booking should be kept when the cancellation is not saved")
+	assert.Empty(t, tm.refunds)
+	payment, ok := provider.Payment(receipt.PaymentId)
+	require.True(t, ok)
+	assert.Zero(t, payment.Refunded, "Nothing should be refunded, however often the cancellation is retried")
+}
+
+func TestRefundsSurviveRestart(t *testing.T) {
+	store, err := NewWALStore(t.TempDir(), 100)
+	require.NoError(t, err)
+	defer store.Close()
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 4}}
+	tm := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	tm.Payments = NewFakePaymentProvider()
+	ctx := context.Background()
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+	response, err := tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+
+	restarted := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	require.NoError(t, restarted.Restore())
+	refund, err := restarted.GetRefund(ctx, &pb.GetRefundRequest{RefundId: response.Refund.RefundId})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), refund.Amount.GetMinorUnits())
+	assert.Equal(t, receipt.BookingReference, refund.BookingReference)
+}
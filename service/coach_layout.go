@@ -0,0 +1,167 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// CoachLayout arranges the seats of a section in rows. Seats are numbered from
+// 1 row by row, left to right, skipping gaps and unavailable positions.
+type CoachLayout struct {
+	Rows int
+	// Columns lists the seat letters across a row from left to right. An
+	// empty string marks a gap, such as the aisle.
+	Columns []string
+	// Unavailable lists positions without a seat, as row number and letter
+	// ("12C"), for example where a door or luggage rack is.
+	Unavailable []string
+}
+
+// seatPosition is one cell of a layout grid.
+type seatPosition struct {
+	// Label is the row number and letter, or empty for a gap.
+	Label string
+	// Seat is the seat number, or zero for gaps and unavailable positions.
+	Seat int
+}
+
+// defaultLayoutColumns seats sections without a layout four abreast.
+var defaultLayoutColumns = []string{"A", "B", "", "C", "D"}
+
+// defaultLayout returns the layout used to display a section configured only
+// by its number of seats.
+func defaultLayout(maxSeats int) CoachLayout {
+	perRow := 0
+	for _, column := range defaultLayoutColumns {
+		if column != "" {
+			perRow++
+		}
+	}
+
+	layout := CoachLayout{Rows: (maxSeats + perRow - 1) / perRow, Columns: defaultLayoutColumns}
+	seat := 0
+	for row := 1; row <= layout.Rows; row++ {
+		for _, column := range layout.Columns {
+			if column == "" {
+				continue
+			}
+			if seat++; seat > maxSeats {
+				layout.Unavailable = append(layout.Unavailable, strconv.Itoa(row)+column)
+			}
+		}
+	}
+	return layout
+}
+
+// validate checks that the layout describes at least one seat and that every
+// unavailable position exists.
+func (l CoachLayout) validate() error {
+	if l.Rows <= 0 {
+		return fmt.Errorf("layout needs at least one row")
+	}
+
+	letters := make(map[string]bool)
+	for _, column := range l.Columns {
+		if column == "" {
+			continue
+		}
+		if letters[column] {
+			return fmt.Errorf("layout repeats seat letter %q", column)
+		}
+		letters[column] = true
+	}
+	if len(letters) == 0 {
+		return fmt.Errorf("layout needs at least one seat letter")
+	}
+
+	for _, label := range l.Unavailable {
+		if !l.hasPosition(label) {
+			return fmt.Errorf("layout has no position %q", label)
+		}
+	}
+	if l.SeatCount() == 0 {
+		return fmt.Errorf("layout has no available seats")
+	}
+	return nil
+}
+
+// hasPosition reports whether label names a position in the layout.
+func (l CoachLayout) hasPosition(label string) bool {
+	for row := 1; row <= l.Rows; row++ {
+		for _, column := range l.Columns {
+			if column != "" && strconv.Itoa(row)+column == label {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// grid returns the positions of the layout row by row.
+func (l CoachLayout) grid() [][]seatPosition {
+	unavailable := make(map[string]bool)
+	for _, label := range l.Unavailable {
+		unavailable[label] = true
+	}
+
+	grid := [][]seatPosition{}
+	seat := 0
+	for row := 1; row <= l.Rows; row++ {
+		cells := make([]seatPosition, len(l.Columns))
+		for i, column := range l.Columns {
+			if column == "" {
+				continue
+			}
+			cells[i].Label = strconv.Itoa(row) + column
+			if !unavailable[cells[i].Label] {
+				seat++
+				cells[i].Seat = seat
+			}
+		}
+		grid = append(grid, cells)
+	}
+	return grid
+}
+
+// SeatCount returns the number of seats in the layout.
+func (l CoachLayout) SeatCount() int {
+	count := 0
+	for _, cells := range l.grid() {
+		for _, cell := range cells {
+			if cell.Seat > 0 {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// features returns the window and aisle seats of the layout. The outermost
+// columns are windows and the columns beside a gap are on the aisle.
+func (l CoachLayout) features() map[int][]SeatFeature {
+	first, last := -1, -1
+	for i, column := range l.Columns {
+		if column != "" {
+			if first < 0 {
+				first = i
+			}
+			last = i
+		}
+	}
+
+	features := make(map[int][]SeatFeature)
+	for _, cells := range l.grid() {
+		for i, cell := range cells {
+			if cell.Seat == 0 {
+				continue
+			}
+			if i == first || i == last {
+				features[cell.Seat] = append(features[cell.Seat], FeatureWindow)
+			}
+			if (i > 0 && l.Columns[i-1] == "") || (i+1 < len(l.Columns) && l.Columns[i+1] == "") {
+				features[cell.Seat] = append(features[cell.Seat], FeatureAisle)
+			}
+		}
+	}
+	return features
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLayout is three rows of two seats each side of the aisle, with no seat
+// at 1D where the door is.
+var testLayout = CoachLayout{
+	Rows:        3,
+	Columns:     []string{"A", "B", "", "C", "D"},
+	Unavailable: []string{"1D"},
+}
+
+func TestCoachLayoutNumbering(t *testing.T) {
+	require.NoError(t, testLayout.validate())
+	assert.Equal(t, 11, testLayout.SeatCount())
+
+	grid := testLayout.grid()
+	require.Len(t, grid, 3)
+	assert.Equal(t, []seatPosition{{Label: "1A", Seat: 1}, {Label: "1B", Seat: 2}, {}, {Label: "1C", Seat: 3}, {Label: "1D"}}, grid[0])
+	assert.Equal(t, []seatPosition{{Label: "2A", Seat: 4}, {Label: "2B", Seat: 5}, {}, {Label: "2C", Seat: 6}, {Label: "2D", Seat: 7}}, grid[1])
+}
+
+func TestCoachLayoutFeatures(t *testing.T) {
+	seatManager := NewSeatManager([]SectionConfigs{{SectionName: "A", Layout: &testLayout}}, NewMemoryStore())
+	section := seatManager.Sections["A"]
+
+	assert.Equal(t, 11, section.MaxSeats)
+	assert.Equal(t, []SeatFeature{FeatureWindow}, section.SeatFeatures(1))
+	assert.Equal(t, []SeatFeature{FeatureAisle}, section.SeatFeatures(2))
+	assert.Equal(t, []SeatFeature{FeatureAisle}, section.SeatFeatures(3))
+	assert.Equal(t, []SeatFeature{FeatureWindow}, section.SeatFeatures(7))
+
+	seats, err := seatManager.AssignMatchingSeats("London", "France", 1, SeatRequest{Required: []SeatFeature{FeatureWindow}, Preferred: []SeatFeature{FeatureAisle}})
+	require.NoError(t, err)
+	assert.Equal(t, []SeatAssignment{{Seat: 1, Section: "A"}}, seats)
+}
+
+func TestCoachLayoutValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		layout CoachLayout
+	}{
+		{name: "No rows", layout: CoachLayout{Columns: []string{"A"}}},
+		{name: "No seat letters", layout: CoachLayout{Rows: 1, Columns: []string{""}}},
+		{name: "Repeated letter", layout: CoachLayout{Rows: 1, Columns: []string{"A", "A"}}},
+		{name: "Unknown position", layout: CoachLayout{Rows: 1, Columns: []string{"A"}, Unavailable: []string{"2A"}}},
+		{name: "Every position unavailable", layout: CoachLayout{Rows: 1, Columns: []string{"A"}, Unavailable: []string{"1A"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Error(t, tc.layout.validate())
+
+			config := DepartureConfig{ID: "bad", Sections: []SectionConfigs{{SectionName: "A", Layout: &tc.layout}}}
+			assert.Error(t, config.validate())
+		})
+	}
+}
+
+func TestDefaultLayout(t *testing.T) {
+	layout := defaultLayout(6)
+
+	assert.Equal(t, 2, layout.Rows)
+	assert.Equal(t, []string{"2C", "2D"}, layout.Unavailable)
+	assert.Equal(t, 6, layout.SeatCount())
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPort is the port the server listens on when no config sets one.
+const DefaultPort = 50051
+
+// Environment variables that override a config file.
+const (
+	EnvConfigPath = "TRAIN_TICKET_CONFIG"
+	EnvPort       = "TRAIN_TICKET_PORT"
+	// EnvStations lists the stations, separated by commas.
+	EnvStations = "TRAIN_TICKET_STATIONS"
+	// EnvSections and EnvFares are JSON arrays in the form of the config
+	// file's sections and fares.
+	EnvSections = "TRAIN_TICKET_SECTIONS"
+	EnvFares    = "TRAIN_TICKET_FARES"
+)
+
+// Config is the declarative setup of the server: the port it listens on, the
+// stations served, the coach sections of the default departure and the fares
+// between stations. It is loaded from a YAML or JSON file.
+type Config struct {
+	Port     int           `yaml:"port" json:"port"`
+	Stations []string      `yaml:"stations" json:"stations"`
+	Sections []SectionSpec `yaml:"sections" json:"sections"`
+	Fares    []FareSpec    `yaml:"fares" json:"fares"`
+}
+
+// SectionSpec is the config of one coach section.
+type SectionSpec struct {
+	Name     string `yaml:"name" json:"name"`
+	MaxSeats int    `yaml:"max_seats" json:"max_seats"`
+	// FareClass is the class the seats are sold in. Empty means
+	// FareClassStandard.
+	FareClass string `yaml:"fare_class" json:"fare_class"`
+	// Features apply to every seat in the section, such as "quiet-zone".
+	Features []string `yaml:"features" json:"features"`
+	// Layout arranges the seats in rows; max_seats is then left out.
+	Layout *CoachLayout `yaml:"layout" json:"layout"`
+}
+
+// FareSpec is the config of the direct leg between two stations.
+type FareSpec struct {
+	From string `yaml:"from" json:"from"`
+	To   string `yaml:"to" json:"to"`
+	// Fare is the standard-class fare in minor units.
+	Fare int64 `yaml:"fare" json:"fare"`
+	// ClassFares are the fares of other classes, such as first, in minor
+	// units. Classes left out are charged the standard fare.
+	ClassFares map[string]int64 `yaml:"class_fares" json:"class_fares"`
+}
+
+// DefaultConfig returns the config the server runs with when it is given no
+// config file.
+func DefaultConfig() Config {
+	return Config{
+		Port:     DefaultPort,
+		Stations: []string{"London", "France"},
+		Sections: []SectionSpec{
+			{Name: "A", MaxSeats: 50, Features: []string{string(FeatureQuietZone)}},
+			{Name: "B", MaxSeats: 50, Features: []string{string(FeaturePowerSocket)}},
+			{Name: "F", MaxSeats: 20, FareClass: FareClassFirst, Features: []string{string(FeaturePowerSocket)}},
+		},
+		Fares: []FareSpec{
+			{From: "London", To: "France", Fare: 2000, ClassFares: map[string]int64{FareClassFirst: 3500}},
+		},
+	}
+}
+
+// LoadConfig reads and validates a config file. The format follows the file
+// extension: .yaml, .yml or .json. Unknown keys are rejected, and a port left
+// out defaults to DefaultPort.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read config: %w", err)
+	}
+	config, err := ParseConfig(data, filepath.Ext(path))
+	if err != nil {
+		return Config{}, fmt.Errorf("config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// ParseConfig decodes and validates a config in the format of a file
+// extension.
+func ParseConfig(data []byte, ext string) (Config, error) {
+	config := Config{Port: DefaultPort}
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&config); err != nil {
+			return Config{}, err
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&config); err != nil {
+			return Config{}, err
+		}
+	default:
+		return Config{}, fmt.Errorf("unsupported config format %q, use .yaml, .yml or .json", ext)
+	}
+	if err := config.Validate(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// ApplyEnv overrides the port, stations, sections and fares of the config
+// with the environment variables that are set, as returned by getenv, and
+// validates the result.
+func (c *Config) ApplyEnv(getenv func(string) string) error {
+	for _, key := range []string{EnvPort, EnvStations, EnvSections, EnvFares} {
+		if err := c.Override(key, getenv(key)); err != nil {
+			return err
+		}
+	}
+	return c.Validate()
+}
+
+// Override sets the part of the config named by an environment variable,
+// such as EnvSections, from a value in that variable's form. A list given
+// replaces the whole list, and an empty value leaves the config as it is.
+// The result is not validated.
+func (c *Config) Override(key, value string) error {
+	if value == "" {
+		return nil
+	}
+	switch key {
+	case EnvPort:
+		port, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s: invalid port %q", key, value)
+		}
+		c.Port = port
+	case EnvStations:
+		stations := strings.Split(value, ",")
+		for i := range stations {
+			stations[i] = strings.TrimSpace(stations[i])
+		}
+		c.Stations = stations
+	case EnvSections:
+		sections, err := decodeOverride[SectionSpec](key, value)
+		if err != nil {
+			return err
+		}
+		c.Sections = sections
+	case EnvFares:
+		fares, err := decodeOverride[FareSpec](key, value)
+		if err != nil {
+			return err
+		}
+		c.Fares = fares
+	default:
+		return fmt.Errorf("unknown config override %s", key)
+	}
+	return nil
+}
+
+// decodeOverride decodes a JSON array override, rejecting unknown keys as a
+// config file does.
+func decodeOverride[T any](key, value string) ([]T, error) {
+	var items []T
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&items); err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return items, nil
+}
+
+// Validate checks the config and reports every problem found, each prefixed
+// with where it is.
+func (c Config) Validate() error {
+	var errs []error
+	fail := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		fail("port: %d is not between 1 and 65535", c.Port)
+	}
+
+	stations := make(map[string]bool)
+	if len(c.Stations) < 2 {
+		fail("stations: at least two stations are required")
+	}
+	for i, station := range c.Stations {
+		if station == "" || strings.Contains(station, "-") || stations[station] {
+			fail("stations[%d]: invalid or repeated station %q", i, station)
+		}
+		stations[station] = true
+	}
+
+	// Sections are sold in standard or in a class that some fare prices.
+	classes := map[string]bool{FareClassStandard: true}
+	for _, fare := range c.Fares {
+		for class := range fare.ClassFares {
+			classes[class] = true
+		}
+	}
+
+	sections := make(map[string]bool)
+	if len(c.Sections) == 0 {
+		fail("sections: at least one section is required")
+	}
+	for i, section := range c.Sections {
+		if section.Name == "" || sections[section.Name] {
+			fail("sections[%d]: invalid or repeated name %q", i, section.Name)
+		}
+		sections[section.Name] = true
+		switch {
+		case section.Layout != nil && section.MaxSeats != 0:
+			fail("sections[%d] (%s): set max_seats or layout, not both", i, section.Name)
+		case section.Layout != nil:
+			if err := section.Layout.validate(); err != nil {
+				fail("sections[%d] (%s): layout: %v", i, section.Name, err)
+			}
+		case section.MaxSeats <= 0:
+			fail("sections[%d] (%s): max_seats must be positive", i, section.Name)
+		}
+		if !classes[fareClassOrDefault(section.FareClass)] {
+			fail("sections[%d] (%s): fare class %q has no fares", i, section.Name, section.FareClass)
+		}
+		for _, feature := range section.Features {
+			if _, err := ParseSeatFeature(feature); err != nil {
+				fail("sections[%d] (%s): %v", i, section.Name, err)
+			}
+		}
+	}
+
+	legs := make(map[string]bool)
+	if len(c.Fares) == 0 {
+		fail("fares: at least one fare is required")
+	}
+	for i, fare := range c.Fares {
+		leg := fare.From + "-" + fare.To
+		for _, station := range []string{fare.From, fare.To} {
+			if !stations[station] {
+				fail("fares[%d] (%s): unknown station %q", i, leg, station)
+			}
+		}
+		if fare.From == fare.To || legs[leg] {
+			fail("fares[%d] (%s): invalid or repeated leg", i, leg)
+		}
+		legs[leg] = true
+		if fare.Fare <= 0 {
+			fail("fares[%d] (%s): fare must be positive", i, leg)
+		}
+		for class, classFare := range fare.ClassFares {
+			if class == "" || class == FareClassStandard || classFare <= 0 {
+				fail("fares[%d] (%s): invalid %q fare %d", i, leg, class, classFare)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SectionConfigs returns the sections of the config. The config must be
+// valid.
+func (c Config) SectionConfigs() []SectionConfigs {
+	configs := []SectionConfigs{}
+	for _, section := range c.Sections {
+		features := []SeatFeature{}
+		for _, feature := range section.Features {
+			features = append(features, SeatFeature(feature))
+		}
+		configs = append(configs, SectionConfigs{
+			SectionName: section.Name,
+			MaxSeats:    section.MaxSeats,
+			Layout:      section.Layout,
+			Features:    features,
+			FareClass:   section.FareClass,
+		})
+	}
+	return configs
+}
+
+// StationConnections returns the "From-To" connections of the config and
+// their standard fares, as taken by NewTicketManager.
+func (c Config) StationConnections() map[string]int64 {
+	connections := make(map[string]int64)
+	for _, fare := range c.Fares {
+		connections[fare.From+"-"+fare.To] = fare.Fare
+	}
+	return connections
+}
+
+// FareTables returns the "From-To" fares of each class other than standard,
+// as taken by RouteGraph.AddFareTable.
+func (c Config) FareTables() map[string]map[string]int64 {
+	tables := make(map[string]map[string]int64)
+	for _, fare := range c.Fares {
+		for class, classFare := range fare.ClassFares {
+			if tables[class] == nil {
+				tables[class] = make(map[string]int64)
+			}
+			tables[class][fare.From+"-"+fare.To] = classFare
+		}
+	}
+	return tables
+}
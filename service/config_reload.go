@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+)
+
+// ApplyConfig reloads the fares and sections of a config into the running
+// service as one change: the route graph and fare tables are replaced, and the
+// default departure's sections are added, resized, changed or removed to
+// match, except for sections added or resized with AdminService, which win
+// over the config and are kept as they are. The reload is rejected, leaving
+// everything as it was, if a booking or hold travels on a leg the config
+// drops, or if a seat that is assigned or held would be removed or sold in
+// another fare class. Seats that are kept keep their state, and closed
+// sections stay closed. The port only changes on restart.
+func (t *TicketManager) ApplyConfig(config Config) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	routes, err := newFareGraph(config.StationConnections(), config.FareTables())
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.checkLegs(routes); err != nil {
+		return err
+	}
+	if err := t.SeatManager.replaceSections(config.SectionConfigs()); err != nil {
+		return err
+	}
+	t.Routes.replaceFares(routes)
+	t.promoteWaitlists()
+	return nil
+}
+
+// checkLegs checks that every leg booked or held is a leg of routes. Callers
+// must hold t.mu.
+func (t *TicketManager) checkLegs(routes *RouteGraph) error {
+	for key, receipt := range t.Receipts {
+		for _, leg := range bookedLegs(receipt) {
+			if !routes.hasLeg(leg.From, leg.To) {
+				return fmt.Errorf("booking %s travels on leg %s-%s, which the config removes", key, leg.From, leg.To)
+			}
+		}
+	}
+	for id, hold := range t.holds {
+		for _, leg := range flattenLegs(hold.legs) {
+			if !routes.hasLeg(leg.From, leg.To) {
+				return fmt.Errorf("hold %s travels on leg %s-%s, which the config removes", id, leg.From, leg.To)
+			}
+		}
+	}
+	return nil
+}
+
+// replaceSections makes the sections match configs, in their order.
+// Administered sections are kept as they are whatever configs says, and those
+// missing from configs follow the others. Seats added or removed are stored as
+// available in a single commit, so that a failed commit changes nothing. It
+// refuses to remove a seat that is held or assigned on any segment, or to move
+// such a seat to another fare class.
+func (s *SeatManager) replaceSections(configs []SectionConfigs) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := max(len(s.Stops)-1, 1)
+	available := initializeSeats(1, segments)[1]
+	changes := make(map[string]map[int][]string)
+	added, removed := []SeatAssignment{}, []SeatAssignment{}
+	change := func(name string, first, last int, seats *[]SeatAssignment) {
+		key := departureStoreKey(s.departureID, name)
+		for seat := first; seat <= last; seat++ {
+			if changes[key] == nil {
+				changes[key] = make(map[int][]string)
+			}
+			changes[key][seat] = available
+		}
+		*seats = append(*seats, seatRange(name, first, last)...)
+	}
+
+	sections := make(map[string]*Section)
+	order := []string{}
+	// Sections the config removes are no longer stored.
+	dropped := make(map[string]*SectionState)
+	for _, config := range configs {
+		section := newSection(config, segments)
+		old, ok := s.Sections[config.SectionName]
+		switch {
+		case ok && old.administered:
+			section = old
+		case !ok:
+			change(section.Name, 1, section.MaxSeats, &added)
+		default:
+			if taken := takenSeats(old, 1, old.MaxSeats); len(taken) > 0 && old.FareClass != section.FareClass {
+				return fmt.Errorf("%w: seats %v of section %s are taken in fare class %s", errSeatUnavailable, taken, old.Name, old.FareClass)
+			}
+			if taken := takenSeats(old, section.MaxSeats+1, old.MaxSeats); len(taken) > 0 {
+				return fmt.Errorf("%w: seats %v of section %s are taken", errSeatUnavailable, taken, old.Name)
+			}
+			for seat := 1; seat <= min(old.MaxSeats, section.MaxSeats); seat++ {
+				section.Occupancy[seat] = old.Occupancy[seat]
+			}
+			section.Closed = old.Closed
+			change(section.Name, old.MaxSeats+1, section.MaxSeats, &added)
+			change(section.Name, section.MaxSeats+1, old.MaxSeats, &removed)
+		}
+		sections[section.Name] = section
+		order = append(order, section.Name)
+	}
+	for _, name := range s.nextSections {
+		old := s.Sections[name]
+		if _, ok := sections[name]; ok {
+			continue
+		}
+		if old.administered {
+			sections[name] = old
+			order = append(order, name)
+			continue
+		}
+		if taken := takenSeats(old, 1, old.MaxSeats); len(taken) > 0 {
+			return fmt.Errorf("%w: seats %v of section %s are taken", errSeatUnavailable, taken, name)
+		}
+		change(name, 1, old.MaxSeats, &removed)
+		dropped[departureStoreKey(s.departureID, name)] = nil
+	}
+
+	if len(changes) > 0 {
+		if err := s.store.Commit(&Mutation{Seats: changes, Sections: dropped}); err != nil {
+			return fmt.Errorf("persist seat change: %w", err)
+		}
+	}
+
+	// Removed seats are reported while they still exist.
+	if len(removed) > 0 {
+		s.publish(pb.SeatEventKind_SEAT_EVENT_KIND_REMOVED, "", "", removed)
+	}
+	// Allocation carries on from the same section if it is kept.
+	next := 0
+	if s.nextSection < len(s.nextSections) {
+		next = max(slices.Index(order, s.nextSections[s.nextSection]), 0)
+	}
+	s.Sections = sections
+	s.nextSections = order
+	s.nextSection = next
+	if len(added) > 0 {
+		s.publish(pb.SeatEventKind_SEAT_EVENT_KIND_ADDED, "", "", added)
+	}
+	return nil
+}
+
+// ConfigReloader applies a config file to a running TicketManager when the
+// file changes or when asked to, such as on SIGHUP. A rejected reload is
+// logged and the service keeps running with the config it had.
+type ConfigReloader struct {
+	path    string
+	tickets *TicketManager
+	// Override, when set, changes every config read before it is applied,
+	// so that environment and flag overrides outlast a reload.
+	Override func(*Config) error
+	mu       sync.Mutex
+	// loaded is the file content last read, applied or not, so that a
+	// rejected file is not retried until it changes again.
+	loaded []byte
+}
+
+// NewConfigReloader returns a reloader of the config file at path, which
+// tickets was started with.
+func NewConfigReloader(path string, tickets *TicketManager) *ConfigReloader {
+	loaded, _ := os.ReadFile(path)
+	return &ConfigReloader{path: path, tickets: tickets, loaded: loaded}
+}
+
+// Reload reads the config file and applies it.
+func (r *ConfigReloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return r.failed(fmt.Errorf("failed to read config: %w", err))
+	}
+	return r.apply(data)
+}
+
+// reloadIfChanged applies the config file if its content differs from the
+// last one read.
+func (r *ConfigReloader) reloadIfChanged() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := os.ReadFile(r.path)
+	if err != nil || bytes.Equal(data, r.loaded) {
+		// A file being replaced may be missing for a moment; it is read
+		// again on the next check.
+		return nil
+	}
+	return r.apply(data)
+}
+
+// apply parses and applies config file content. Callers must hold r.mu.
+func (r *ConfigReloader) apply(data []byte) error {
+	log.Printf("Config reload received: %s", r.path)
+	r.loaded = data
+
+	config, err := ParseConfig(data, filepath.Ext(r.path))
+	if err != nil {
+		return r.failed(fmt.Errorf("config %s: %w", r.path, err))
+	}
+	if r.Override != nil {
+		if err := r.Override(&config); err != nil {
+			return r.failed(err)
+		}
+	}
+	if err := r.tickets.ApplyConfig(config); err != nil {
+		return r.failed(err)
+	}
+
+	log.Printf("Config reload successful: %s", r.path)
+	return nil
+}
+
+func (r *ConfigReloader) failed(err error) error {
+	log.Printf("Config reload failed: %v", err)
+	return err
+}
+
+// Run checks the config file for changes every interval, and reloads it
+// whenever a value arrives on signals, until ctx is done.
+func (r *ConfigReloader) Run(ctx context.Context, interval time.Duration, signals <-chan os.Signal) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+			r.Reload()
+		case <-ticker.C:
+			r.reloadIfChanged()
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reloadConfig returns a config for the London-Paris route of
+// createPassengerTicketManager with section A of the given size, a first class
+// section F and a Paris-Brussels leg.
+func reloadConfig(seatsInA int) Config {
+	return Config{
+		Port:     DefaultPort,
+		Stations: []string{"London", "Paris", "Brussels"},
+		Sections: []SectionSpec{
+			{Name: "A", MaxSeats: seatsInA},
+			{Name: "F", MaxSeats: 2, FareClass: FareClassFirst, Features: []string{string(FeatureTable)}},
+		},
+		Fares: []FareSpec{
+			{From: "London", To: "Paris", Fare: 2500, ClassFares: map[string]int64{FareClassFirst: 4000}},
+			{From: "Paris", To: "Brussels", Fare: 1000},
+		},
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	tm := createPassengerTicketManager()
+	ctx := context.Background()
+	receipts := bookLondonParis(t, tm, 2)
+	require.NoError(t, tm.SeatManager.SetSectionClosed("A", true))
+	_, watcher, err := tm.SeatManager.watch("", "", 0)
+	require.NoError(t, err)
+	defer tm.SeatManager.unwatch(watcher)
+
+	require.NoError(t, tm.ApplyConfig(reloadConfig(6)))
+
+	section := tm.SeatManager.Sections["A"]
+	assert.Equal(t, 6, section.MaxSeats)
+	assert.True(t, section.Closed, "Closed sections stay closed")
+	for _, receipt := range receipts {
+		assert.Equal(t, "Assigned", section.SeatState(int(receipt.Seat.SeatNumber)), "Booked seats are kept")
+	}
+	assert.Equal(t, []SeatFeature{FeatureTable}, tm.SeatManager.Sections["F"].SeatFeatures(1))
+	added := <-watcher.updates
+	assert.Equal(t, pb.SeatEventKind_SEAT_EVENT_KIND_ADDED, added.GetEvent().GetKind())
+	assert.Len(t, added.GetEvent().GetSeats(), 4)
+
+	fare, err := tm.Routes.LegFare(FareClassFirst, "London", "Paris")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4000), fare)
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Brussels", FareClass: FareClassFirst,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "F", receipt.Seat.Section)
+	assert.Equal(t, int64(5000), receipt.Price.GetMinorUnits(), "New fares apply to new bookings")
+
+	// Shrinking past free seats and dropping an empty section are fine.
+	config := reloadConfig(2)
+	config.Sections = config.Sections[:1]
+	config.Sections = append(config.Sections, SectionSpec{Name: "B", MaxSeats: 1})
+	config.Fares = config.Fares[:1]
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	require.NoError(t, tm.ApplyConfig(config))
+	assert.Equal(t, 2, tm.SeatManager.Sections["A"].MaxSeats)
+	assert.NotContains(t, tm.SeatManager.Sections, "F")
+	assert.Equal(t, []string{"A", "B"}, tm.SeatManager.nextSections)
+	assert.Len(t, tm.Routes.Legs(), 1)
+}
+
+func TestApplyConfigKeepsAdminChanges(t *testing.T) {
+	tm := createPassengerTicketManager()
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+	_, err := admin.AddSection(ctx, &pb.AddSectionRequest{Section: "B", MaxSeats: 3})
+	require.NoError(t, err)
+	_, err = admin.ResizeSection(ctx, &pb.ResizeSectionRequest{Section: "A", MaxSeats: 8})
+	require.NoError(t, err)
+	tm.SeatManager.nextSection = 1
+
+	require.NoError(t, tm.ApplyConfig(reloadConfig(6)))
+
+	seatManager := tm.SeatManager
+	assert.Equal(t, 8, seatManager.Sections["A"].MaxSeats, "An admin resize wins over the file")
+	require.Contains(t, seatManager.Sections, "B", "A section added by an admin is kept")
+	assert.Equal(t, 3, seatManager.Sections["B"].MaxSeats)
+	assert.Equal(t, []string{"A", "F", "B"}, seatManager.nextSections)
+	assert.Equal(t, 2, seatManager.nextSection, "Allocation carries on from the same section")
+	assert.Contains(t, seatManager.Sections, "F", "Sections of the file are still added")
+}
+
+func TestApplyConfigRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func() Config
+		store  Store
+	}{
+		{name: "Invalid config", config: func() Config { return Config{} }},
+		{name: "Booked seat removed", config: func() Config { return reloadConfig(1) }},
+		{
+			name: "Booked section removed",
+			config: func() Config {
+				config := reloadConfig(4)
+				config.Sections = config.Sections[1:]
+				return config
+			},
+		},
+		{
+			name: "Booked section changes class",
+			config: func() Config {
+				config := reloadConfig(4)
+				config.Sections[0].FareClass = FareClassFirst
+				return config
+			},
+		},
+		{
+			name: "Booked leg removed",
+			config: func() Config {
+				config := reloadConfig(4)
+				config.Fares = []FareSpec{{From: "London", To: "Brussels", Fare: 3000}}
+				return config
+			},
+		},
+		{name: "Store failure", config: func() Config { return reloadConfig(6) }, store: failingStore{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seatStore := NewMemoryStore()
+			tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 4}}, seatStore), map[string]int64{"London-Paris": 2000}, NewMemoryStore())
+			bookLondonParis(t, tm, 2)
+			if tt.store != nil {
+				tm.SeatManager.store = tt.store
+			}
+
+			assert.Error(t, tm.ApplyConfig(tt.config()))
+
+			assert.Equal(t, []string{"A"}, tm.SeatManager.nextSections, "A rejected reload changes nothing")
+			assert.Equal(t, 4, tm.SeatManager.Sections["A"].MaxSeats)
+			assert.Equal(t, FareClassStandard, tm.SeatManager.Sections["A"].FareClass)
+			assert.Equal(t, []RouteLeg{{From: "London", To: "Paris", Fare: 2000}}, tm.Routes.Legs())
+			state, err := seatStore.Load()
+			require.NoError(t, err)
+			assert.Len(t, state.Seats["A"], 2, "Only the booked seats are stored")
+		})
+	}
+}
+
+func TestConfigReloader(t *testing.T) {
+	tm := createPassengerTicketManager()
+	bookLondonParis(t, tm, 1)
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	config := func(seats int) string {
+		return fmt.Sprintf("stations: [London, Paris]\nsections: [{name: A, max_seats: %d}]\nfares: [{from: London, to: Paris, fare: 2000}]\n", seats)
+	}
+	write(config(4))
+	reloader := NewConfigReloader(path, tm)
+
+	require.NoError(t, reloader.reloadIfChanged(), "An unchanged file is not reloaded")
+	write(config(6))
+	require.NoError(t, reloader.reloadIfChanged())
+	assert.Equal(t, 6, tm.SeatManager.Sections["A"].MaxSeats)
+
+	write("sections: [")
+	assert.Error(t, reloader.reloadIfChanged())
+	assert.NoError(t, reloader.reloadIfChanged(), "A rejected file is not retried until it changes")
+	assert.Equal(t, 6, tm.SeatManager.Sections["A"].MaxSeats)
+
+	reloader.Override = func(config *Config) error {
+		return config.Override(EnvSections, `[{"name": "A", "max_seats": 8}]`)
+	}
+	write(config(7))
+	require.NoError(t, reloader.reloadIfChanged())
+	assert.Equal(t, 8, tm.SeatManager.Sections["A"].MaxSeats, "Overrides outlast a reload")
+	reloader.Override = nil
+
+	// A signal reloads the file without waiting for the next check.
+	write(config(5))
+	signals := make(chan os.Signal, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Run(ctx, time.Hour, signals)
+	signals <- syscall.SIGHUP
+	assert.Eventually(t, func() bool {
+		tm.mu.Lock()
+		defer tm.mu.Unlock()
+		return tm.SeatManager.Sections["A"].MaxSeats == 5
+	}, time.Second, 10*time.Millisecond)
+}
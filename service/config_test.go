@@ -0,0 +1,136 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("../config.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultConfig(), config, "The sample config should match the built-in one")
+
+	assert.Equal(t, map[string]int64{"London-France": 2000}, config.StationConnections())
+	assert.Equal(t, map[string]map[string]int64{FareClassFirst: {"London-France": 3500}}, config.FareTables())
+	sections := config.SectionConfigs()
+	require.Len(t, sections, 3)
+	assert.Equal(t, SectionConfigs{SectionName: "F", MaxSeats: 20, FareClass: FareClassFirst, Features: []SeatFeature{FeaturePowerSocket}}, sections[2])
+
+	_, err = LoadConfig("missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestParseConfig(t *testing.T) {
+	json := `{
+		"stations": ["London", "Paris", "Brussels"],
+		"sections": [{"name": "A", "layout": {"rows": 2, "columns": ["A", "", "B"]}}],
+		"fares": [
+			{"from": "London", "to": "Paris", "fare": 5000},
+			{"from": "Paris", "to": "Brussels", "fare": 2000, "class_fares": {"first": 3000}}
+		]
+	}`
+	config, err := ParseConfig([]byte(json), ".json")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultPort, config.Port, "Port should default when left out")
+	assert.Equal(t, 4, config.SectionConfigs()[0].seatCount())
+	assert.Equal(t, map[string]int64{"London-Paris": 5000, "Paris-Brussels": 2000}, config.StationConnections())
+
+	tests := []struct {
+		name          string
+		data          string
+		ext           string
+		expectedError string
+	}{
+		{name: "TOML", data: "port = 1", ext: ".toml", expectedError: `unsupported config format ".toml"`},
+		{name: "Unknown key", data: "prot: 1", ext: ".yaml", expectedError: "field prot not found"},
+		{name: "Malformed JSON", data: "{", ext: ".json", expectedError: "unexpected EOF"},
+		{name: "Bad port", data: "port: 70000", ext: ".yml", expectedError: "port: 70000 is not between 1 and 65535"},
+		{name: "Empty", data: "port: 1", ext: ".yaml", expectedError: "sections: at least one section is required"},
+		{
+			name: "Every problem is reported",
+			data: `
+stations: [London, Paris, London]
+sections:
+  - {name: A}
+  - {name: A, max_seats: 2, features: [sunroof]}
+fares:
+  - {from: London, to: Rome, fare: 100}
+  - {from: London, to: Paris, fare: 0, class_fares: {first: -1}}
+`,
+			ext: ".yaml",
+			expectedError: `stations[2]: invalid or repeated station "London"
+sections[0] (A): max_seats must be positive
+sections[1]: invalid or repeated name "A"
+sections[1] (A): unknown seat feature "sunroof"
+fares[0] (London-Rome): unknown station "Rome"
+fares[1] (London-Paris): fare must be positive
+fares[1] (London-Paris): invalid "first" fare -1`,
+		},
+		{
+			name: "Section class without fares",
+			data: `
+stations: [London, Paris]
+sections: [{name: A, max_seats: 2, fare_class: premium}]
+fares: [{from: London, to: Paris, fare: 100, class_fares: {first: 200}}]
+`,
+			ext:           ".yaml",
+			expectedError: `sections[0] (A): fare class "premium" has no fares`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.data), tt.ext)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestConfigApplyEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		expected    func(config *Config)
+		expectError string
+	}{
+		{name: "No overrides", env: map[string]string{}, expected: func(*Config) {}},
+		{name: "Port", env: map[string]string{EnvPort: "6000"}, expected: func(config *Config) { config.Port = 6000 }},
+		{name: "Not a number", env: map[string]string{EnvPort: "http"}, expectError: "invalid port"},
+		{name: "Out of range", env: map[string]string{EnvPort: "0"}, expectError: "port: 0"},
+		{
+			name: "Topology",
+			env: map[string]string{
+				EnvStations: "London, Paris",
+				EnvSections: `[{"name": "A", "max_seats": 10}, {"name": "F", "max_seats": 4, "fare_class": "first"}]`,
+				EnvFares:    `[{"from": "London", "to": "Paris", "fare": 4000, "class_fares": {"first": 6000}}]`,
+			},
+			expected: func(config *Config) {
+				config.Stations = []string{"London", "Paris"}
+				config.Sections = []SectionSpec{{Name: "A", MaxSeats: 10}, {Name: "F", MaxSeats: 4, FareClass: FareClassFirst}}
+				config.Fares = []FareSpec{{From: "London", To: "Paris", Fare: 4000, ClassFares: map[string]int64{FareClassFirst: 6000}}}
+			},
+		},
+		{name: "Malformed sections", env: map[string]string{EnvSections: `[{"name": "A"`}, expectError: EnvSections},
+		{name: "Unknown key", env: map[string]string{EnvFares: `[{"from": "London", "to": "France", "price": 1}]`}, expectError: `unknown field "price"`},
+		{name: "Invalid result", env: map[string]string{EnvStations: "London,Paris"}, expectError: `unknown station "France"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			err := config.ApplyEnv(func(key string) string { return tt.env[key] })
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			expected := DefaultConfig()
+			tt.expected(&expected)
+			assert.Equal(t, expected, config)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultDepartureID identifies the departure served by the SeatManager passed
+// to NewTicketManager. Requests that carry no departure ID are booked on it.
+const DefaultDepartureID = "default"
+
+// Departure is a single run of a train on a service date. Each departure owns
+// its own seat inventory.
+type Departure struct {
+	ID            string
+	TrainID       string
+	ServiceDate   string
+	DepartureTime time.Time
+	SeatManager   *SeatManager
+}
+
+// DepartureConfig describes a departure and the coach sections it runs with.
+type DepartureConfig struct {
+	ID            string
+	TrainID       string
+	ServiceDate   string
+	DepartureTime time.Time
+	// Stops lists the stations called at, in order. Leave empty for a
+	// departure that only runs between two stations.
+	Stops    []string
+	Sections []SectionConfigs
+}
+
+// validate checks that the config describes a usable departure.
+func (c DepartureConfig) validate() error {
+	if c.ID == "" {
+		return fmt.Errorf("departure id is required")
+	}
+	if c.ServiceDate != "" {
+		if _, err := time.Parse(time.DateOnly, c.ServiceDate); err != nil {
+			return fmt.Errorf("departure %s: invalid service date %q", c.ID, c.ServiceDate)
+		}
+	}
+	if len(c.Stops) == 1 {
+		return fmt.Errorf("departure %s: a route needs at least two stops", c.ID)
+	}
+	seen := make(map[string]bool)
+	for _, stop := range c.Stops {
+		if stop == "" || seen[stop] {
+			return fmt.Errorf("departure %s: invalid or repeated stop %q", c.ID, stop)
+		}
+		seen[stop] = true
+	}
+	if len(c.Sections) == 0 {
+		return fmt.Errorf("departure %s: at least one section is required", c.ID)
+	}
+	for _, section := range c.Sections {
+		if section.Layout != nil {
+			if err := section.Layout.validate(); err != nil {
+				return fmt.Errorf("departure %s: section %s: %w", c.ID, section.SectionName, err)
+			}
+		}
+		if section.SectionName == "" || section.seatCount() <= 0 {
+			return fmt.Errorf("departure %s: invalid section %+v", c.ID, section)
+		}
+	}
+	return nil
+}
+
+// departureStoreKey returns the key under which a section's seats are stored.
+// The default departure keeps bare section names so that data written before
+// departures existed still restores.
+func departureStoreKey(departureID, section string) string {
+	if departureID == "" || departureID == DefaultDepartureID {
+		return section
+	}
+	return departureID + "/" + section
+}
+
+// sectionFromStoreKey reverses departureStoreKey, returning the section a key
+// stores for the departure, or false if the key is another departure's.
+func sectionFromStoreKey(departureID, key string) (string, bool) {
+	if departureID == "" || departureID == DefaultDepartureID {
+		return key, !strings.Contains(key, "/")
+	}
+	return strings.CutPrefix(key, departureID+"/")
+}
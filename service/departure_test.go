@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAddDeparture(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    DepartureConfig
+		expectErr bool
+	}{
+		{
+			name: "Valid departure",
+			config: DepartureConfig{
+				ID: "LF100-2025-03-01", TrainID: "LF100", ServiceDate: "2025-03-01",
+				Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}},
+			},
+		},
+		{
+			name:      "Missing id",
+			config:    DepartureConfig{Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}}},
+			expectErr: true,
+		},
+		{
+			name:      "Invalid service date",
+			config:    DepartureConfig{ID: "X", ServiceDate: "01/03/2025", Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}}},
+			expectErr: true,
+		},
+		{
+			name:      "No sections",
+			config:    DepartureConfig{ID: "X"},
+			expectErr: true,
+		},
+		{
+			name:      "Duplicate id",
+			config:    DepartureConfig{ID: DefaultDepartureID, Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createTestTicketManager()
+
+			departure, err := tm.AddDeparture(tt.config)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.config.ID, departure.ID)
+			assert.Contains(t, tm.Departures, tt.config.ID)
+		})
+	}
+}
+
+func TestPurchaseTicketOnDeparture(t *testing.T) {
+	tm := createTestTicketManager()
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID: "LF200-2025-03-02", TrainID: "LF200", ServiceDate: "2025-03-02",
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 1}},
+	})
+	require.NoError(t, err)
+
+	request := func(email, departureID string) *pb.PurchaseTicketRequest {
+		return &pb.PurchaseTicketRequest{
+			User:        &pb.User{FirstName: "Nandha", LastName: "Kumar", Email: email},
+			From:        "London",
+			To:          "France",
+			DepartureId: departureID,
+		}
+	}
+
+	receipt, err := tm.PurchaseTicket(context.Background(), request("one@example.com", "LF200-2025-03-02"))
+	require.NoError(t, err)
+	assert.Equal(t, "LF200-2025-03-02", receipt.DepartureId)
+	assert.Equal(t, "Assigned", tm.Departures["LF200-2025-03-02"].SeatManager.Sections["A"].SeatState(1))
+
+	_, err = tm.PurchaseTicket(context.Background(), request("two@example.com", "LF200-2025-03-02"))
+	assert.Error(t, err, "The single seat on the departure is already sold")
+
+	receipt, err = tm.PurchaseTicket(context.Background(), request("three@example.com", ""))
+	require.NoError(t, err, "The default departure has its own inventory")
+	assert.Equal(t, DefaultDepartureID, receipt.DepartureId)
+
+	_, err = tm.PurchaseTicket(context.Background(), request("four@example.com", "missing"))
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+
+	users, err := tm.GetUsersBySection(context.Background(), &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "LF200-2025-03-02"})
+	require.NoError(t, err)
+	require.Len(t, users.Users, 1)
+	assert.Equal(t, "one@example.com", users.Users[0].User.Email)
+
+	_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{Email: "one@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "Available", tm.Departures["LF200-2025-03-02"].SeatManager.Sections["A"].SeatState(1))
+}
+
+func TestListDepartures(t *testing.T) {
+	tm := createTestTicketManager()
+	departureTime := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	for _, config := range []DepartureConfig{
+		{ID: "LF100-2025-03-01", TrainID: "LF100", ServiceDate: "2025-03-01", DepartureTime: departureTime, Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 5}}},
+		{ID: "LF100-2025-03-02", TrainID: "LF100", ServiceDate: "2025-03-02", Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 5}}},
+		{ID: "LF200-2025-03-01", TrainID: "LF200", ServiceDate: "2025-03-01", Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 5}}},
+	} {
+		_, err := tm.AddDeparture(config)
+		require.NoError(t, err)
+	}
+
+	resp, err := tm.ListDepartures(context.Background(), &pb.ListDeparturesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Departures, 4, "All departures including the default one")
+
+	resp, err = tm.ListDepartures(context.Background(), &pb.ListDeparturesRequest{TrainId: "LF100", ServiceDate: "2025-03-01"})
+	require.NoError(t, err)
+	require.Len(t, resp.Departures, 1)
+	assert.Equal(t, departureTime, resp.Departures[0].DepartureTime.AsTime())
+	assert.Equal(t, int32(5), resp.Departures[0].Sections[0].MaxSeats)
+}
+
+func TestRestoreDepartureSeats(t *testing.T) {
+	store := NewMemoryStore()
+	config := DepartureConfig{ID: "LF100-2025-03-01", Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 2}}}
+	sectionConfigs := []SectionConfigs{{SectionName: "A", MaxSeats: 2}}
+
+	tm := NewTicketManager(NewSeatManager(sectionConfigs, store), map[string]int64{"London-France": 2000}, store)
+	_, err := tm.AddDeparture(config)
+	require.NoError(t, err)
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "a@example.com"}, From: "London", To: "France", DepartureId: config.ID,
+	})
+	require.NoError(t, err)
+
+	restarted := NewTicketManager(NewSeatManager(sectionConfigs, store), map[string]int64{"London-France": 2000}, store)
+	_, err = restarted.AddDeparture(config)
+	require.NoError(t, err)
+	require.NoError(t, restarted.Restore())
+
+	seat := int(receipt.Seat.SeatNumber)
+	assert.Equal(t, "Assigned", restarted.Departures[config.ID].SeatManager.Sections["A"].SeatState(seat))
+	assert.Equal(t, "Available", restarted.SeatManager.Sections["A"].SeatState(seat), "Default departure seats are separate")
+}
+
+func TestPurchaseTicketOnMultiStopDeparture(t *testing.T) {
+	tm := createTestTicketManager()
+	require.NoError(t, tm.Routes.AddLeg(RouteLeg{From: "London", To: "Paris", Fare: 1500}))
+	require.NoError(t, tm.Routes.AddLeg(RouteLeg{From: "Paris", To: "Brussels", Fare: 1000}))
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID:       "LB300-2025-03-01",
+		Stops:    []string{"London", "Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 1}},
+	})
+	require.NoError(t, err)
+
+	purchase := func(email, from, to string) (*pb.TicketReceipt, error) {
+		return tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{Email: email}, From: from, To: to, DepartureId: "LB300-2025-03-01",
+		})
+	}
+
+	first, err := purchase("first@example.com", "London", "Paris")
+	require.NoError(t, err)
+	second, err := purchase("second@example.com", "Paris", "Brussels")
+	require.NoError(t, err, "The seat sold London-Paris is still free Paris-Brussels")
+	assert.Equal(t, first.Seat.SeatNumber, second.Seat.SeatNumber)
+
+	_, err = purchase("third@example.com", "London", "France")
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code(), "France is not on the route")
+
+	// Restore must keep both partial bookings on the shared seat.
+	restarted := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 50}}, tm.store), map[string]int64{}, tm.store)
+	_, err = restarted.AddDeparture(DepartureConfig{
+		ID:       "LB300-2025-03-01",
+		Stops:    []string{"London", "Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 1}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, restarted.Restore())
+	assert.Equal(t, []string{"Assigned", "Assigned"}, restarted.Departures["LB300-2025-03-01"].SeatManager.Sections["A"].Occupancy[1])
+}
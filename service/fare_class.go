@@ -0,0 +1,30 @@
+package service
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// FareClassStandard is the class of sections configured without one and
+	// of bookings that ask for none.
+	FareClassStandard = "standard"
+	FareClassFirst    = "first"
+)
+
+// fareClassOrDefault returns class, or FareClassStandard when it is empty.
+func fareClassOrDefault(class string) string {
+	if class == "" {
+		return FareClassStandard
+	}
+	return class
+}
+
+// checkFareClass checks that a fare class asked for is sold: standard, or a
+// class with a fare table.
+func (t *TicketManager) checkFareClass(class string) error {
+	if !t.Routes.hasFareClass(class) {
+		return status.Errorf(codes.InvalidArgument, "unknown fare class %q", class)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createFareClassTicketManager returns a manager with a two-seat first-class
+// section F and a two-seat standard section S on London-Paris, which costs 2000
+// pence in standard and 3500 in first.
+func createFareClassTicketManager(t *testing.T) *TicketManager {
+	sections := []SectionConfigs{
+		{SectionName: "F", MaxSeats: 2, FareClass: FareClassFirst},
+		{SectionName: "S", MaxSeats: 2},
+	}
+	tm := NewTicketManager(NewSeatManager(sections, NewMemoryStore()), map[string]int64{"London-Paris": 2000}, NewMemoryStore())
+	require.NoError(t, tm.Routes.AddFareTable(FareClassFirst, map[string]int64{"London-Paris": 3500}))
+	return tm
+}
+
+func TestAddFareTable(t *testing.T) {
+	graph := NewRouteGraph(map[string]int64{"London-Paris": 5000, "Paris-Lyon": 3000})
+
+	tests := []struct {
+		name      string
+		class     string
+		fares     map[string]int64
+		expectErr bool
+	}{
+		{name: "Valid table", class: FareClassFirst, fares: map[string]int64{"London-Paris": 8000}},
+		{name: "Missing class", class: "", fares: map[string]int64{"London-Paris": 8000}, expectErr: true},
+		{name: "Unknown leg", class: FareClassFirst, fares: map[string]int64{"Paris-London": 8000}, expectErr: true},
+		{name: "Invalid connection", class: FareClassFirst, fares: map[string]int64{"London": 8000}, expectErr: true},
+		{name: "Zero fare", class: FareClassFirst, fares: map[string]int64{"London-Paris": 0}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := graph.AddFareTable(tt.class, tt.fares)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	// Legs missing from a class table fall back to the standard fare.
+	fare, err := graph.LegFare(FareClassFirst, "London", "Paris")
+	require.NoError(t, err)
+	assert.Equal(t, int64(8000), fare)
+	fare, err = graph.LegFare(FareClassFirst, "Paris", "Lyon")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3000), fare)
+	assert.Equal(t, int64(3000), graph.ClassFare(FareClassFirst, JourneyLeg{From: "Paris", To: "Lyon", Fare: 3000}))
+
+	_, err = graph.LegFare(FareClassFirst, "Lyon", "Paris")
+	assert.Error(t, err)
+}
+
+func TestPurchaseTicketFareClass(t *testing.T) {
+	tests := []struct {
+		name            string
+		fareClass       string
+		expectedSection string
+		expectedPrice   int64
+		expectedClass   string
+	}{
+		{name: "Default class is standard", fareClass: "", expectedSection: "S", expectedPrice: 2000, expectedClass: FareClassStandard},
+		{name: "Standard class", fareClass: FareClassStandard, expectedSection: "S", expectedPrice: 2000, expectedClass: FareClassStandard},
+		{name: "First class", fareClass: FareClassFirst, expectedSection: "F", expectedPrice: 3500, expectedClass: FareClassFirst},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createFareClassTicketManager(t)
+
+			receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+				User:      &pb.User{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"},
+				From:      "London",
+				To:        "Paris",
+				FareClass: tt.fareClass,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSection, receipt.Seat.Section)
+			assert.Equal(t, tt.expectedPrice, receipt.Price.GetMinorUnits())
+			assert.Equal(t, tt.expectedClass, receipt.FareClass)
+			assert.Equal(t, tt.expectedClass, receipt.Legs[0].FareClass)
+			assert.Equal(t, tt.expectedPrice, receipt.Legs[0].Price.GetMinorUnits())
+		})
+	}
+}
+
+func TestPurchaseGroupTicketFareClassSoldOut(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+
+	// Three passengers do not fit in the two first-class seats, even though
+	// standard class has room.
+	_, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+		From: "London",
+		To:   "Paris",
+		Passengers: []*pb.User{
+			{FirstName: "Ada", Email: "ada@example.com"},
+			{FirstName: "Charles"},
+			{FirstName: "Mary"},
+		},
+		FareClass: FareClassFirst,
+	})
+	assert.Error(t, err)
+
+	for seat := 1; seat <= 2; seat++ {
+		assert.Equal(t, "Available", tm.SeatManager.Sections["F"].SeatState(seat))
+		assert.Equal(t, "Available", tm.SeatManager.Sections["S"].SeatState(seat))
+	}
+}
+
+func TestModifyUserSeatFareDifference(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com"},
+		From: "London",
+		To:   "Paris",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "S", receipt.Seat.Section)
+
+	tests := []struct {
+		name               string
+		newSeat            *pb.Seat
+		expectedDifference int64
+		expectedPrice      int64
+		expectedClass      string
+	}{
+		{name: "Same class", newSeat: &pb.Seat{Section: "S", SeatNumber: 2}, expectedDifference: 0, expectedPrice: 2000, expectedClass: FareClassStandard},
+		{name: "Upgrade to first", newSeat: &pb.Seat{Section: "F", SeatNumber: 1}, expectedDifference: 1500, expectedPrice: 3500, expectedClass: FareClassFirst},
+		{name: "Downgrade to standard", newSeat: &pb.Seat{Section: "S", SeatNumber: 1}, expectedDifference: -1500, expectedPrice: 2000, expectedClass: FareClassStandard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modified, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+				BookingReference: receipt.BookingReference,
+				NewSeat:          tt.newSeat,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDifference, modified.FareDifference.GetMinorUnits())
+			assert.Equal(t, tt.expectedPrice, modified.Price.GetMinorUnits())
+			assert.Equal(t, tt.expectedPrice, modified.Legs[0].Price.GetMinorUnits())
+			assert.Equal(t, tt.expectedClass, modified.Legs[0].FareClass)
+			assert.Equal(t, tt.expectedClass, modified.FareClass, "The receipt takes the class of its seat")
+
+			// The difference is only reported, never stored.
+			stored, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{BookingReference: receipt.BookingReference})
+			require.NoError(t, err)
+			assert.Zero(t, stored.FareDifference)
+			assert.Equal(t, tt.expectedPrice, stored.Price.GetMinorUnits())
+		})
+	}
+}
+
+func TestModifyUserSeatClassChangeDiscount(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	require.NoError(t, tm.AddPromoCode(PromoCode{Code: "QUARTER", Percent: 25}))
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PromoCodes: []string{"QUARTER"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1500), receipt.Price.GetMinorUnits())
+
+	modified, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "F", SeatNumber: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(875), modified.Discount.GetMinorUnits(), "The discount is a quarter of the new fare")
+	require.Len(t, modified.Discounts, 1)
+	assert.Equal(t, int64(875), modified.Discounts[0].Amount.GetMinorUnits())
+	assert.Equal(t, int64(2625), modified.Price.GetMinorUnits())
+	assert.Equal(t, int64(1125), modified.FareDifference.GetMinorUnits())
+	discount := modified.LineItems[len(modified.LineItems)-1]
+	assert.Equal(t, pb.LineItemKind_LINE_ITEM_KIND_DISCOUNT, discount.Kind)
+	assert.Equal(t, int64(-875), discount.Amount.GetMinorUnits())
+}
+
+func TestModifyUserSeatClassChangeWithPayments(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	tm.Payments = NewFakePaymentProvider()
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+
+	_, err = tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "F", SeatNumber: 1},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "An upgrade would go unpaid")
+	assert.Equal(t, "Assigned", tm.SeatManager.Sections[receipt.Seat.Section].SeatState(int(receipt.Seat.SeatNumber)))
+
+	moved, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "S", SeatNumber: 2},
+	})
+	require.NoError(t, err, "Seat changes within a class are still allowed")
+	assert.Zero(t, moved.FareDifference.GetMinorUnits())
+}
+
+func TestUnknownFareClass(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	ctx := context.Background()
+	user := &pb.User{FirstName: "Ada", Email: "ada@example.com"}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "PurchaseTicket", call: func() error {
+			_, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{User: user, From: "London", To: "Paris", FareClass: "premium"})
+			return err
+		}},
+		{name: "PurchaseGroupTicket", call: func() error {
+			_, err := tm.PurchaseGroupTicket(ctx, &pb.PurchaseGroupTicketRequest{Passengers: []*pb.User{user}, From: "London", To: "Paris", FareClass: "premium"})
+			return err
+		}},
+		{name: "HoldSeats", call: func() error {
+			_, err := tm.HoldSeats(ctx, &pb.HoldSeatsRequest{From: "London", To: "Paris", FareClass: "premium"})
+			return err
+		}},
+		{name: "JoinWaitlist", call: func() error {
+			_, err := tm.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{User: user, From: "London", To: "Paris", FareClass: "premium"})
+			return err
+		}},
+		{name: "GetPriceQuote", call: func() error {
+			_, err := tm.GetPriceQuote(ctx, &pb.GetPriceQuoteRequest{From: "London", To: "Paris", FareClass: "premium"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, codes.InvalidArgument, status.Code(tt.call()))
+		})
+	}
+	assert.Empty(t, tm.Receipts)
+}
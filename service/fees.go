@@ -0,0 +1,229 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+)
+
+// FeeRules are the fees and taxes a FeeCalculator adds to fares. Amounts are
+// in minor units of the pricing currency.
+type FeeRules struct {
+	// BookingFee is charged once per booking.
+	BookingFee int64
+	// SeatReservationFee is charged for every seat on every leg. Infants on
+	// a lap have no seat and pay none.
+	SeatReservationFee int64
+	// Jurisdictions maps a station to the tax jurisdiction it is in, such as
+	// "UK". A leg is taxed where it starts; the booking fee is taxed where the
+	// journey starts.
+	Jurisdictions map[string]string
+	// VATPercent is the VAT rate of each jurisdiction. Charges in other
+	// jurisdictions, or at stations without one, carry no VAT.
+	VATPercent map[string]float64
+}
+
+// validate checks that no fee is negative and that every VAT rate is a
+// percentage.
+func (r FeeRules) validate() error {
+	if r.BookingFee < 0 || r.SeatReservationFee < 0 {
+		return fmt.Errorf("fees must not be negative")
+	}
+	for station, jurisdiction := range r.Jurisdictions {
+		if station == "" || jurisdiction == "" {
+			return fmt.Errorf("invalid jurisdiction %q for station %q", jurisdiction, station)
+		}
+	}
+	for jurisdiction, percent := range r.VATPercent {
+		if jurisdiction == "" || percent < 0 || percent > 100 {
+			return fmt.Errorf("jurisdiction %s: invalid VAT rate %v%%", jurisdiction, percent)
+		}
+	}
+	return nil
+}
+
+// Charge is one item of a booking's price breakdown.
+type Charge struct {
+	Kind        pb.LineItemKind
+	Description string
+	Amount      int64
+	// From, To and Passenger identify the leg of a fare or seat
+	// reservation fee.
+	From      string
+	To        string
+	Passenger int
+	// Jurisdiction is set on VAT charges.
+	Jurisdiction string
+}
+
+// Breakdown itemizes the price of a booking before discounts.
+type Breakdown struct {
+	Charges []Charge
+	// Fares, Fees and Tax total the charges of each kind.
+	Fares int64
+	Fees  int64
+	Tax   int64
+}
+
+// Total is the price of the booking before discounts, the sum of every charge.
+func (b Breakdown) Total() int64 {
+	return b.Fares + b.Fees + b.Tax
+}
+
+// FeeCalculator adds booking fees, seat reservation fees and VAT to fares. A
+// calculator without rules charges the fares alone.
+type FeeCalculator struct {
+	mu    sync.RWMutex
+	rules FeeRules
+}
+
+// NewFeeCalculator creates a calculator applying the given rules.
+func NewFeeCalculator(rules FeeRules) (*FeeCalculator, error) {
+	calculator := &FeeCalculator{}
+	if err := calculator.SetRules(rules); err != nil {
+		return nil, err
+	}
+	return calculator, nil
+}
+
+// SetRules replaces the rules used for later breakdowns.
+func (c *FeeCalculator) SetRules(rules FeeRules) error {
+	if err := rules.validate(); err != nil {
+		return err
+	}
+
+	jurisdictions := make(map[string]string, len(rules.Jurisdictions))
+	for station, jurisdiction := range rules.Jurisdictions {
+		jurisdictions[station] = jurisdiction
+	}
+	vat := make(map[string]float64, len(rules.VATPercent))
+	for jurisdiction, percent := range rules.VATPercent {
+		vat[jurisdiction] = percent
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rules = FeeRules{
+		BookingFee:         rules.BookingFee,
+		SeatReservationFee: rules.SeatReservationFee,
+		Jurisdictions:      jurisdictions,
+		VATPercent:         vat,
+	}
+	return nil
+}
+
+// Rules returns the rules in use.
+func (c *FeeCalculator) Rules() FeeRules {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rules
+}
+
+// Breakdown itemizes a booking whose passengers travel on the given legs, in
+// order: each passenger's fares and seat reservation fees leg by leg, the
+// booking fee, then the VAT of each jurisdiction in name order. VAT is worked
+// out once per jurisdiction on the charges taxed there and rounded half away
+// from zero, so the charges add up to the total exactly.
+func (c *FeeCalculator) Breakdown(passengerLegs [][]*pb.Leg) Breakdown {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	breakdown := Breakdown{Charges: []Charge{}}
+	taxable := make(map[string]int64)
+	for p, legs := range passengerLegs {
+		for _, leg := range legs {
+			jurisdiction := c.rules.Jurisdictions[leg.From]
+			fare := leg.Price.GetMinorUnits()
+			breakdown.Charges = append(breakdown.Charges, Charge{
+				Kind:        pb.LineItemKind_LINE_ITEM_KIND_FARE,
+				Description: fmt.Sprintf("%s-%s fare", leg.From, leg.To),
+				Amount:      fare,
+				From:        leg.From,
+				To:          leg.To,
+				Passenger:   p,
+			})
+			breakdown.Fares += fare
+			taxable[jurisdiction] += fare
+
+			if leg.Seat == nil || c.rules.SeatReservationFee == 0 {
+				continue
+			}
+			breakdown.Charges = append(breakdown.Charges, Charge{
+				Kind:        pb.LineItemKind_LINE_ITEM_KIND_SEAT_RESERVATION_FEE,
+				Description: fmt.Sprintf("%s-%s seat reservation", leg.From, leg.To),
+				Amount:      c.rules.SeatReservationFee,
+				From:        leg.From,
+				To:          leg.To,
+				Passenger:   p,
+			})
+			breakdown.Fees += c.rules.SeatReservationFee
+			taxable[jurisdiction] += c.rules.SeatReservationFee
+		}
+	}
+
+	if c.rules.BookingFee > 0 && len(passengerLegs) > 0 && len(passengerLegs[0]) > 0 {
+		breakdown.Charges = append(breakdown.Charges, Charge{
+			Kind:        pb.LineItemKind_LINE_ITEM_KIND_BOOKING_FEE,
+			Description: "Booking fee",
+			Amount:      c.rules.BookingFee,
+		})
+		breakdown.Fees += c.rules.BookingFee
+		taxable[c.rules.Jurisdictions[passengerLegs[0][0].From]] += c.rules.BookingFee
+	}
+
+	jurisdictions := make([]string, 0, len(taxable))
+	for jurisdiction := range taxable {
+		if c.rules.VATPercent[jurisdiction] > 0 {
+			jurisdictions = append(jurisdictions, jurisdiction)
+		}
+	}
+	sort.Strings(jurisdictions)
+	for _, jurisdiction := range jurisdictions {
+		percent := c.rules.VATPercent[jurisdiction]
+		vat := scaleMinorUnits(taxable[jurisdiction], new(big.Rat).Quo(ratio(percent), big.NewRat(100, 1)))
+		breakdown.Charges = append(breakdown.Charges, Charge{
+			Kind:         pb.LineItemKind_LINE_ITEM_KIND_VAT,
+			Description:  fmt.Sprintf("VAT %v%% (%s)", percent, jurisdiction),
+			Amount:       vat,
+			Jurisdiction: jurisdiction,
+		})
+		breakdown.Tax += vat
+	}
+	return breakdown
+}
+
+// itemize sets a receipt's line items and totals from the breakdown of its
+// price and the promo code discounts it already carries, and sets its price to
+// the breakdown total less the discount.
+func (t *TicketManager) itemize(receipt *pb.TicketReceipt, breakdown Breakdown) {
+	receipt.LineItems = []*pb.LineItem{}
+	for _, charge := range breakdown.Charges {
+		receipt.LineItems = append(receipt.LineItems, &pb.LineItem{
+			Kind:         charge.Kind,
+			Description:  charge.Description,
+			Amount:       newMoney(t.Currency, charge.Amount),
+			From:         charge.From,
+			To:           charge.To,
+			Passenger:    int32(charge.Passenger),
+			Jurisdiction: charge.Jurisdiction,
+		})
+	}
+	for _, discount := range receipt.Discounts {
+		receipt.LineItems = append(receipt.LineItems, &pb.LineItem{
+			Kind:        pb.LineItemKind_LINE_ITEM_KIND_DISCOUNT,
+			Description: "Promo code " + discount.Code,
+			Amount:      newMoney(t.Currency, -discount.Amount.GetMinorUnits()),
+		})
+	}
+
+	receipt.FareTotal = newMoney(t.Currency, breakdown.Fares)
+	receipt.FeeTotal = newMoney(t.Currency, breakdown.Fees)
+	receipt.TaxTotal = newMoney(t.Currency, breakdown.Tax)
+	receipt.OriginalPrice = newMoney(t.Currency, breakdown.Total())
+	receipt.Price = newMoney(t.Currency, breakdown.Total()-receipt.Discount.GetMinorUnits())
+}
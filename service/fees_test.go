@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lineItemTotal sums the line items of a receipt.
+func lineItemTotal(receipt *pb.TicketReceipt) int64 {
+	total := int64(0)
+	for _, item := range receipt.LineItems {
+		total += item.Amount.GetMinorUnits()
+	}
+	return total
+}
+
+func TestFeeCalculatorBreakdown(t *testing.T) {
+	calculator, err := NewFeeCalculator(FeeRules{
+		BookingFee:         150,
+		SeatReservationFee: 300,
+		Jurisdictions:      map[string]string{"London": "UK", "Paris": "FR"},
+		VATPercent:         map[string]float64{"UK": 20, "FR": 10},
+	})
+	require.NoError(t, err)
+
+	seat := &pb.Seat{Section: "A", SeatNumber: 1}
+	breakdown := calculator.Breakdown([][]*pb.Leg{
+		{
+			{From: "London", To: "Paris", Price: newMoney("GBP", 2000), Seat: seat},
+			{From: "Paris", To: "Lyon", Price: newMoney("GBP", 1500), Seat: seat},
+		},
+		// An infant on a lap pays no fare and no seat reservation fee.
+		{
+			{From: "London", To: "Paris", Price: newMoney("GBP", 0)},
+			{From: "Paris", To: "Lyon", Price: newMoney("GBP", 0)},
+		},
+	})
+
+	expected := []struct {
+		kind   pb.LineItemKind
+		amount int64
+	}{
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_FARE, amount: 2000},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_SEAT_RESERVATION_FEE, amount: 300},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_FARE, amount: 1500},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_SEAT_RESERVATION_FEE, amount: 300},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_FARE, amount: 0},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_FARE, amount: 0},
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_BOOKING_FEE, amount: 150},
+		// 10% of 1500 + 300 taxed in Paris.
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_VAT, amount: 180},
+		// 20% of 2000 + 300 + 150 taxed in London.
+		{kind: pb.LineItemKind_LINE_ITEM_KIND_VAT, amount: 490},
+	}
+	require.Len(t, breakdown.Charges, len(expected))
+	for i, charge := range breakdown.Charges {
+		assert.Equal(t, expected[i].kind, charge.Kind, "charge %d", i)
+		assert.Equal(t, expected[i].amount, charge.Amount, "charge %d", i)
+	}
+	assert.Equal(t, "FR", breakdown.Charges[7].Jurisdiction)
+	assert.Equal(t, "UK", breakdown.Charges[8].Jurisdiction)
+	assert.Equal(t, 1, breakdown.Charges[4].Passenger)
+
+	assert.Equal(t, int64(3500), breakdown.Fares)
+	assert.Equal(t, int64(750), breakdown.Fees)
+	assert.Equal(t, int64(670), breakdown.Tax)
+	assert.Equal(t, int64(4920), breakdown.Total())
+
+	// VAT is rounded once per jurisdiction: 17.5% of 1003 is 175.525.
+	require.NoError(t, calculator.SetRules(FeeRules{
+		Jurisdictions: map[string]string{"London": "UK"},
+		VATPercent:    map[string]float64{"UK": 17.5},
+	}))
+	breakdown = calculator.Breakdown([][]*pb.Leg{{{From: "London", To: "Paris", Price: newMoney("GBP", 1003), Seat: seat}}})
+	assert.Equal(t, int64(176), breakdown.Tax)
+	assert.Len(t, breakdown.Charges, 2)
+}
+
+func TestFeeRulesValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		rules     FeeRules
+		expectErr bool
+	}{
+		{name: "No rules"},
+		{name: "Valid rules", rules: FeeRules{BookingFee: 150, Jurisdictions: map[string]string{"London": "UK"}, VATPercent: map[string]float64{"UK": 20}}},
+		{name: "Negative booking fee", rules: FeeRules{BookingFee: -1}, expectErr: true},
+		{name: "Negative seat fee", rules: FeeRules{SeatReservationFee: -1}, expectErr: true},
+		{name: "Station without jurisdiction", rules: FeeRules{Jurisdictions: map[string]string{"London": ""}}, expectErr: true},
+		{name: "VAT over 100%", rules: FeeRules{VATPercent: map[string]float64{"UK": 120}}, expectErr: true},
+		{name: "Negative VAT", rules: FeeRules{VATPercent: map[string]float64{"UK": -5}}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFeeCalculator(tt.rules)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPurchaseTicketLineItems(t *testing.T) {
+	tm := createPassengerTicketManager()
+	require.NoError(t, tm.Fees.SetRules(FeeRules{
+		BookingFee:         150,
+		SeatReservationFee: 300,
+		Jurisdictions:      map[string]string{"London": "UK"},
+		VATPercent:         map[string]float64{"UK": 20},
+	}))
+	_, err := NewAdminServer(tm).CreatePromoCode(context.Background(), &pb.PromoCode{Code: "TENOFF", PercentOff: 10})
+	require.NoError(t, err)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PromoCodes: []string{"TENOFF"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(2000), receipt.FareTotal.GetMinorUnits())
+	assert.Equal(t, int64(450), receipt.FeeTotal.GetMinorUnits())
+	assert.Equal(t, int64(490), receipt.TaxTotal.GetMinorUnits())
+	assert.Equal(t, int64(2940), receipt.OriginalPrice.GetMinorUnits())
+	assert.Equal(t, int64(294), receipt.Discount.GetMinorUnits())
+	assert.Equal(t, int64(2646), receipt.Price.GetMinorUnits())
+	require.Len(t, receipt.LineItems, 5)
+	assert.Equal(t, pb.LineItemKind_LINE_ITEM_KIND_DISCOUNT, receipt.LineItems[4].Kind)
+	assert.Equal(t, int64(-294), receipt.LineItems[4].Amount.GetMinorUnits())
+	assert.Equal(t, receipt.Price.GetMinorUnits(), lineItemTotal(receipt), "Line items should add up to the price")
+
+	group, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+		From: "London", To: "Paris",
+		Passengers: []*pb.User{
+			{FirstName: "Bo", Email: "bo@example.com"},
+			{FirstName: "Cy", PassengerType: pb.PassengerType_PASSENGER_TYPE_INFANT, DateOfBirth: bornYearsAgo(1)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(450), group.FeeTotal.GetMinorUnits(), "Infant should pay no seat reservation fee")
+	assert.Equal(t, int64(2940), group.Price.GetMinorUnits())
+	assert.Equal(t, group.Price.GetMinorUnits(), lineItemTotal(group))
+}
+
+func TestModifyUserSeatLineItems(t *testing.T) {
+	tm := createFareClassTicketManager(t)
+	require.NoError(t, tm.Fees.SetRules(FeeRules{
+		SeatReservationFee: 300,
+		Jurisdictions:      map[string]string{"London": "UK"},
+		VATPercent:         map[string]float64{"UK": 20},
+	}))
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2760), receipt.Price.GetMinorUnits())
+
+	modified, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference,
+		NewSeat:          &pb.Seat{Section: "F", SeatNumber: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3500), modified.FareTotal.GetMinorUnits())
+	assert.Equal(t, int64(760), modified.TaxTotal.GetMinorUnits(), "VAT should follow the new fare")
+	assert.Equal(t, int64(4560), modified.Price.GetMinorUnits())
+	assert.Equal(t, int64(1800), modified.FareDifference.GetMinorUnits())
+	assert.Equal(t, modified.Price.GetMinorUnits(), lineItemTotal(modified))
+}
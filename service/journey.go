@@ -0,0 +1,206 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// receiptLegs returns the legs of a receipt. Receipts written before journeys
+// had legs are treated as a single leg built from their top-level fields.
+func receiptLegs(receipt *pb.TicketReceipt) []*pb.Leg {
+	if len(receipt.GetLegs()) > 0 {
+		return receipt.Legs
+	}
+	return []*pb.Leg{{
+		From:        receipt.GetFrom(),
+		To:          receipt.GetTo(),
+		DepartureId: receipt.GetDepartureId(),
+		Seat:        receipt.GetSeat(),
+		Price:       receipt.GetPrice(),
+	}}
+}
+
+// receiptPassengers returns the passengers of a receipt with their legs. A
+// single-passenger booking is returned as one passenger holding its legs.
+func receiptPassengers(receipt *pb.TicketReceipt) []*pb.Passenger {
+	if len(receipt.GetPassengers()) > 0 {
+		return receipt.Passengers
+	}
+	return []*pb.Passenger{{User: receipt.GetUser(), Legs: receiptLegs(receipt)}}
+}
+
+// bookedLegs returns the legs of every passenger on a receipt.
+func bookedLegs(receipt *pb.TicketReceipt) []*pb.Leg {
+	legs := []*pb.Leg{}
+	for _, passenger := range receiptPassengers(receipt) {
+		legs = append(legs, passenger.Legs...)
+	}
+	return legs
+}
+
+// receiptPassengerLegs returns the legs of each passenger on a receipt.
+func receiptPassengerLegs(receipt *pb.TicketReceipt) [][]*pb.Leg {
+	legs := [][]*pb.Leg{}
+	for _, passenger := range receiptPassengers(receipt) {
+		legs = append(legs, passenger.Legs)
+	}
+	return legs
+}
+
+// flattenLegs returns the legs of every passenger in one list.
+func flattenLegs(passengerLegs [][]*pb.Leg) []*pb.Leg {
+	legs := []*pb.Leg{}
+	for _, passenger := range passengerLegs {
+		legs = append(legs, passenger...)
+	}
+	return legs
+}
+
+// seatedLegs returns the legs of every passenger on a receipt that have a
+// seat, leaving out those of infants travelling on a lap.
+func seatedLegs(receipt *pb.TicketReceipt) []*pb.Leg {
+	legs := []*pb.Leg{}
+	for _, leg := range bookedLegs(receipt) {
+		if leg.Seat != nil {
+			legs = append(legs, leg)
+		}
+	}
+	return legs
+}
+
+// legSeats returns the SeatManager holding the seat of a journey leg.
+func (t *TicketManager) legSeats(leg *pb.Leg) (*SeatManager, error) {
+	departure, ok := t.departure(leg.GetDepartureId())
+	if !ok {
+		return nil, fmt.Errorf("departure %s not found", leg.GetDepartureId())
+	}
+	return departure.SeatManager, nil
+}
+
+// legDeparture picks the departure that carries a leg. The requested departure
+// is used whenever its route covers the leg; when required is set it must.
+// Otherwise the earliest departure whose stop list covers the leg and that
+// leaves no earlier than after is chosen, ordered by departure time and then
+// ID. Departures without a stop list accept any journey, so they are only
+// used when requested.
+func (t *TicketManager) legDeparture(requested *Departure, required bool, leg JourneyLeg, after time.Time) (*Departure, error) {
+	if _, _, err := requested.SeatManager.segmentRange(leg.From, leg.To); err == nil {
+		return requested, nil
+	}
+	if required {
+		return nil, fmt.Errorf("departure %s does not serve %s-%s", requested.ID, leg.From, leg.To)
+	}
+
+	candidates := []*Departure{}
+	for _, departure := range t.Departures {
+		if departure == requested || len(departure.SeatManager.Stops) < 2 {
+			continue
+		}
+		if _, _, err := departure.SeatManager.segmentRange(leg.From, leg.To); err != nil {
+			continue
+		}
+		if !after.IsZero() && !departure.DepartureTime.IsZero() && departure.DepartureTime.Before(after) {
+			continue
+		}
+		candidates = append(candidates, departure)
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no departure serves %s-%s", leg.From, leg.To)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].DepartureTime.Equal(candidates[j].DepartureTime) {
+			return candidates[i].DepartureTime.Before(candidates[j].DepartureTime)
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	return candidates[0], nil
+}
+
+// bookJourney assigns a seat matching request for each of count passengers on
+// every leg of a journey and returns the legs booked for each passenger.
+// Either everything is booked or, on failure, the seats already assigned are
+// released again. Callers must hold t.mu.
+func (t *TicketManager) bookJourney(requested *Departure, required bool, journey []JourneyLeg, count int, request SeatRequest) ([][]*pb.Leg, error) {
+	assign := func(seats *SeatManager, _ int, leg JourneyLeg) ([]SeatAssignment, error) {
+		return seats.AssignMatchingSeats(leg.From, leg.To, count, request)
+	}
+	rollback := func(booked [][]*pb.Leg) {
+		t.releasePassengerLegs(booked)
+	}
+	return t.seatJourney(requested, required, journey, count, request, assign, rollback)
+}
+
+// seatJourney picks a departure for every leg of a journey and calls assign to
+// take count seats on it, returning the legs taken for each passenger, priced
+// in the requested fare class by the pricing engine, with the preferences in
+// request that each seat meets. When a leg fails, rollback is
+// called with the legs taken so far. Callers must hold t.mu.
+func (t *TicketManager) seatJourney(requested *Departure, required bool, journey []JourneyLeg, count int, request SeatRequest,
+	assign func(seats *SeatManager, index int, leg JourneyLeg) ([]SeatAssignment, error),
+	rollback func(booked [][]*pb.Leg)) ([][]*pb.Leg, error) {
+	booked := make([][]*pb.Leg, count)
+	var after time.Time
+
+	for i, leg := range journey {
+		departure, err := t.legDeparture(requested, required && i == 0, leg, after)
+		if err != nil {
+			rollback(booked)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// Price before taking the seats, so a booking does not pay for the
+		// occupancy it adds itself.
+		priced := t.priceLeg(departure, leg, fareClassOrDefault(request.FareClass), time.Now())
+
+		assignments, err := assign(departure.SeatManager, i, leg)
+		if err != nil {
+			rollback(booked)
+			return nil, fmt.Errorf("%s-%s: %w", leg.From, leg.To, err)
+		}
+
+		for p, assignment := range assignments {
+			booked[p] = append(booked[p], proto.Clone(priced).(*pb.Leg))
+			booked[p][i].Seat = &pb.Seat{SeatNumber: int32(assignment.Seat), Section: assignment.Section}
+			booked[p][i].FareClass, _ = departure.SeatManager.SectionFareClass(assignment.Section)
+			booked[p][i].SatisfiedPreferences = seatFeaturesToProto(request.satisfied(
+				departure.SeatManager.SeatFeatures(assignment.Section, assignment.Seat)))
+		}
+		after = departure.DepartureTime
+	}
+
+	return booked, nil
+}
+
+// releasePassengerLegs releases the seats booked for several passengers.
+func (t *TicketManager) releasePassengerLegs(booked [][]*pb.Leg) error {
+	var firstErr error
+	for _, legs := range booked {
+		if err := t.releaseLegs(legs); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// releaseLegs releases the seats of journey legs, returning the first error.
+func (t *TicketManager) releaseLegs(legs []*pb.Leg) error {
+	var firstErr error
+	for _, leg := range legs {
+		seatManager, err := t.legSeats(leg)
+		if err == nil {
+			err = seatManager.ReleaseSeat(int(leg.GetSeat().GetSeatNumber()), leg.GetSeat().GetSection(), leg.GetFrom(), leg.GetTo())
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
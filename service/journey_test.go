@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createConnectingTicketManager sets up a London-Paris train and a later
+// Paris-Brussels train, with no direct London-Brussels service.
+func createConnectingTicketManager(t *testing.T, connectionSeats int) *TicketManager {
+	t.Helper()
+
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 5}}, store), map[string]int64{
+		"London-Paris":   5000,
+		"Paris-Brussels": 2000,
+	}, store)
+
+	departureTime := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID: "LP-0900", DepartureTime: departureTime, Stops: []string{"London", "Paris"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 5}},
+	})
+	require.NoError(t, err)
+	_, err = tm.AddDeparture(DepartureConfig{
+		ID: "PB-0700", DepartureTime: departureTime.Add(-2 * time.Hour), Stops: []string{"Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 5}},
+	})
+	require.NoError(t, err)
+	_, err = tm.AddDeparture(DepartureConfig{
+		ID: "PB-1200", DepartureTime: departureTime.Add(3 * time.Hour), Stops: []string{"Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: connectionSeats}},
+	})
+	require.NoError(t, err)
+
+	return tm
+}
+
+func TestPurchaseConnectingJourney(t *testing.T) {
+	tm := createConnectingTicketManager(t, 5)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "connect@example.com"}, From: "London", To: "Brussels", DepartureId: "LP-0900",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, receipt.Legs, 2)
+	assert.Equal(t, "LP-0900", receipt.Legs[0].DepartureId)
+	assert.Equal(t, "PB-1200", receipt.Legs[1].DepartureId, "The connection must leave after the first train")
+	assert.Equal(t, int64(7000), receipt.Price.GetMinorUnits())
+	assert.Equal(t, receipt.Legs[0].Seat, receipt.Seat)
+
+	users, err := tm.GetUsersBySection(context.Background(), &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "PB-1200"})
+	require.NoError(t, err)
+	assert.Len(t, users.Users, 1)
+
+	// Changing the seat of the second leg leaves the first leg untouched.
+	newSeat := receipt.Legs[1].Seat.SeatNumber%5 + 1
+	modified, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+		Email: "connect@example.com", NewSeat: &pb.Seat{SeatNumber: newSeat, Section: "A"}, Leg: 1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, newSeat, modified.Legs[1].Seat.SeatNumber)
+	assert.Equal(t, receipt.Seat.SeatNumber, modified.Seat.SeatNumber)
+	assert.Equal(t, "Assigned", tm.Departures["PB-1200"].SeatManager.Sections["A"].SeatState(int(newSeat)))
+
+	_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{Email: "connect@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "Available", tm.Departures["LP-0900"].SeatManager.Sections["A"].SeatState(int(receipt.Seat.SeatNumber)))
+	assert.Equal(t, "Available", tm.Departures["PB-1200"].SeatManager.Sections["A"].SeatState(int(newSeat)))
+}
+
+func TestPurchaseConnectingJourneyRollsBack(t *testing.T) {
+	tm := createConnectingTicketManager(t, 1)
+	tm.Departures["PB-1200"].SeatManager.Sections["A"].Occupancy[1] = []string{"Assigned"}
+
+	_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "connect@example.com"}, From: "London", To: "Brussels", DepartureId: "LP-0900",
+	})
+	assert.Error(t, err, "The connecting train is full")
+
+	for seat := range tm.Departures["LP-0900"].SeatManager.Sections["A"].Occupancy {
+		assert.Equal(t, "Available", tm.Departures["LP-0900"].SeatManager.Sections["A"].SeatState(seat), "First leg must be released")
+	}
+	assert.Empty(t, tm.Receipts)
+}
+
+func TestPurchaseGroupTicket(t *testing.T) {
+	passengers := []*pb.User{
+		{FirstName: "Ada", Email: "lead@example.com"},
+		{FirstName: "Bob"},
+		{FirstName: "Cy"},
+	}
+
+	t.Run("Seats every passenger on every leg", func(t *testing.T) {
+		tm := createConnectingTicketManager(t, 5)
+
+		receipt, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+			From: "London", To: "Brussels", Passengers: passengers, DepartureId: "LP-0900",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, receipt.Passengers, 3)
+		assert.Equal(t, int64(21000), receipt.Price.GetMinorUnits())
+		assert.Equal(t, receipt.Passengers[0].Legs, receipt.Legs)
+		for leg := range receipt.Legs {
+			first := receipt.Passengers[0].Legs[leg].Seat
+			for p, passenger := range receipt.Passengers {
+				require.Len(t, passenger.Legs, 2)
+				assert.Equal(t, first.Section, passenger.Legs[leg].Seat.Section)
+				assert.Equal(t, first.SeatNumber+int32(p), passenger.Legs[leg].Seat.SeatNumber, "Group should sit together")
+			}
+		}
+
+		users, err := tm.GetUsersBySection(context.Background(), &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "PB-1200"})
+		require.NoError(t, err)
+		assert.Len(t, users.Users, 3)
+
+		_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+		require.NoError(t, err)
+		for _, id := range []string{"LP-0900", "PB-1200"} {
+			departure, _ := tm.departure(id)
+			for seat := 1; seat <= 5; seat++ {
+				assert.Equal(t, "Available", departure.SeatManager.Sections["A"].SeatState(seat))
+			}
+		}
+	})
+
+	t.Run("Books nothing when a leg cannot seat everyone", func(t *testing.T) {
+		tm := createConnectingTicketManager(t, 2)
+
+		_, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+			From: "London", To: "Brussels", Passengers: passengers, DepartureId: "LP-0900",
+		})
+		require.Error(t, err)
+		assert.Empty(t, tm.Receipts)
+
+		departure, _ := tm.departure("LP-0900")
+		for seat := 1; seat <= 5; seat++ {
+			assert.Equal(t, "Available", departure.SeatManager.Sections["A"].SeatState(seat), "First leg must be rolled back")
+		}
+	})
+
+	t.Run("Modifies one passenger's seat", func(t *testing.T) {
+		tm := createConnectingTicketManager(t, 5)
+
+		receipt, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+			From: "London", To: "Paris", Passengers: passengers, DepartureId: "LP-0900",
+		})
+		require.NoError(t, err)
+
+		updated, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+			BookingReference: receipt.BookingReference, Passenger: 2, NewSeat: &pb.Seat{SeatNumber: 5, Section: "A"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), updated.Passengers[2].Legs[0].Seat.SeatNumber)
+		assert.Equal(t, receipt.Seat, updated.Seat, "Lead passenger keeps their seat")
+	})
+
+	t.Run("Rejects an empty group", func(t *testing.T) {
+		tm := createConnectingTicketManager(t, 5)
+
+		_, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{From: "London", To: "Paris"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+)
+
+const (
+	// DefaultManifestPageSize is the page size of a manifest request that
+	// gives none.
+	DefaultManifestPageSize = 100
+	// MaxManifestPageSize is the largest page a manifest request may ask for.
+	MaxManifestPageSize = 1000
+)
+
+// sectionManifest indexes the bookings and holds with a seat in one section of
+// one departure, so that listing a section does not scan every receipt.
+type sectionManifest struct {
+	// receipts and holds hold receipt keys and hold IDs.
+	receipts map[string]bool
+	holds    map[string]bool
+}
+
+// manifest returns the index of a section of a departure, creating it if
+// needed. Callers must hold t.mu.
+func (t *TicketManager) manifest(departureID, section string) *sectionManifest {
+	sections, ok := t.manifests[departureID]
+	if !ok {
+		sections = make(map[string]*sectionManifest)
+		t.manifests[departureID] = sections
+	}
+	manifest, ok := sections[section]
+	if !ok {
+		manifest = &sectionManifest{receipts: make(map[string]bool), holds: make(map[string]bool)}
+		sections[section] = manifest
+	}
+	return manifest
+}
+
+// legSection returns the departure ID and section of a seated leg, or false
+// for a leg without a seat or on an unknown departure. Callers must hold t.mu.
+func (t *TicketManager) legSection(leg *pb.Leg) (string, string, bool) {
+	departure, ok := t.departure(leg.GetDepartureId())
+	if !ok || leg.GetSeat() == nil {
+		return "", "", false
+	}
+	return departure.ID, leg.Seat.Section, true
+}
+
+// putReceipt stores a receipt under its key and indexes its seats, replacing
+// any receipt stored there before. Callers must hold t.mu.
+func (t *TicketManager) putReceipt(key string, receipt *pb.TicketReceipt) {
+	if old, ok := t.Receipts[key]; ok {
+		t.unindexReceipt(key, old)
+	}
+	t.Receipts[key] = receipt
+	for _, leg := range bookedLegs(receipt) {
+		if departureID, section, ok := t.legSection(leg); ok {
+			t.manifest(departureID, section).receipts[key] = true
+		}
+	}
+}
+
+// deleteReceipt removes a receipt and its seats from the index. Callers must
+// hold t.mu.
+func (t *TicketManager) deleteReceipt(key string) {
+	if receipt, ok := t.Receipts[key]; ok {
+		t.unindexReceipt(key, receipt)
+	}
+	delete(t.Receipts, key)
+}
+
+// unindexReceipt removes the seats of a receipt from the index. Callers must
+// hold t.mu.
+func (t *TicketManager) unindexReceipt(key string, receipt *pb.TicketReceipt) {
+	for _, leg := range bookedLegs(receipt) {
+		if departureID, section, ok := t.legSection(leg); ok {
+			delete(t.manifest(departureID, section).receipts, key)
+		}
+	}
+}
+
+// putHold stores a hold and indexes its seats. Callers must hold t.mu.
+func (t *TicketManager) putHold(id string, hold *journeyHold) {
+	t.holds[id] = hold
+	for _, leg := range flattenLegs(hold.legs) {
+		if departureID, section, ok := t.legSection(leg); ok {
+			t.manifest(departureID, section).holds[id] = true
+		}
+	}
+}
+
+// deleteHold forgets a hold and removes its seats from the index. Callers
+// must hold t.mu.
+func (t *TicketManager) deleteHold(id string) {
+	if hold, ok := t.holds[id]; ok {
+		for _, leg := range flattenLegs(hold.legs) {
+			if departureID, section, ok := t.legSection(leg); ok {
+				delete(t.manifest(departureID, section).holds, id)
+			}
+		}
+	}
+	delete(t.holds, id)
+}
+
+// rebuildManifests indexes the seats of every receipt and hold afresh.
+// Callers must hold t.mu.
+func (t *TicketManager) rebuildManifests() {
+	t.manifests = make(map[string]map[string]*sectionManifest)
+	receipts := t.Receipts
+	t.Receipts = make(map[string]*pb.TicketReceipt, len(receipts))
+	for key, receipt := range receipts {
+		t.putReceipt(key, receipt)
+	}
+	for id, hold := range t.holds {
+		t.putHold(id, hold)
+	}
+}
+
+// manifestEntry is one seat of a section manifest with the key it is sorted
+// by.
+type manifestEntry struct {
+	ticket *pb.UserTicket
+	key    manifestKey
+}
+
+// manifestKey orders the entries of a manifest. Every entry has its own key:
+// the seat of one passenger on one leg of a booking or hold. A page token
+// holds the key of the last entry on its page.
+type manifestKey struct {
+	LastName  string `json:"l,omitempty"`
+	FirstName string `json:"f,omitempty"`
+	Seat      int32  `json:"s,omitempty"`
+	// Reference is the booking reference or hold ID.
+	Reference string `json:"r,omitempty"`
+	Passenger int    `json:"p,omitempty"`
+	Leg       int    `json:"g,omitempty"`
+}
+
+// compare orders two keys by seat number or by surname, then by booking,
+// passenger and leg.
+func (k manifestKey) compare(other manifestKey, order pb.ManifestSortOrder) int {
+	byName := 0
+	if order == pb.ManifestSortOrder_MANIFEST_SORT_ORDER_SURNAME {
+		byName = cmp.Or(cmp.Compare(k.LastName, other.LastName), cmp.Compare(k.FirstName, other.FirstName))
+	}
+	return cmp.Or(
+		byName,
+		cmp.Compare(k.Seat, other.Seat),
+		cmp.Compare(k.Reference, other.Reference),
+		cmp.Compare(k.Passenger, other.Passenger),
+		cmp.Compare(k.Leg, other.Leg),
+	)
+}
+
+// manifestPageToken resumes a manifest query after the entry with key After.
+// Query fingerprints the request it was issued for.
+type manifestPageToken struct {
+	Query uint64      `json:"q"`
+	After manifestKey `json:"a"`
+}
+
+// manifestQuery fingerprints the fields of a manifest request that decide its
+// entries and their order, so that a page token is only used for the query
+// it was issued for.
+func manifestQuery(departureID string, req *pb.GetUsersBySectionRequest) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%q %q %d %q %q %q %d", departureID, req.Section, req.SortBy, req.From, req.To, strings.ToLower(req.NamePrefix), req.Status)
+	return h.Sum64()
+}
+
+func encodeManifestPageToken(token manifestPageToken) string {
+	encoded, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+func decodeManifestPageToken(encoded string) (manifestPageToken, error) {
+	var token manifestPageToken
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return token, err
+	}
+	err = json.Unmarshal(decoded, &token)
+	return token, err
+}
+
+// matches reports whether an entry passes the filters of a manifest request.
+func (e manifestEntry) matches(req *pb.GetUsersBySectionRequest) bool {
+	if req.Status != pb.ManifestStatus_MANIFEST_STATUS_UNSPECIFIED && e.ticket.Status != req.Status {
+		return false
+	}
+	if req.From != "" && e.ticket.From != req.From {
+		return false
+	}
+	if req.To != "" && e.ticket.To != req.To {
+		return false
+	}
+	if req.NamePrefix != "" {
+		prefix := strings.ToLower(req.NamePrefix)
+		user := e.ticket.GetUser()
+		if user == nil || (!strings.HasPrefix(strings.ToLower(user.FirstName), prefix) && !strings.HasPrefix(strings.ToLower(user.LastName), prefix)) {
+			return false
+		}
+	}
+	return true
+}
+
+// manifestEntries returns the seats of a section of a departure taken by
+// bookings and by holds that have not expired at now, unsorted. Callers must
+// hold t.mu.
+func (t *TicketManager) manifestEntries(departure *Departure, section string, now time.Time) []manifestEntry {
+	entries := []manifestEntry{}
+	manifest, ok := t.manifests[departure.ID][section]
+	if !ok {
+		return entries
+	}
+
+	onSection := func(leg *pb.Leg) bool {
+		legDeparture, ok := t.departure(leg.DepartureId)
+		return ok && legDeparture == departure && leg.Seat.GetSection() == section
+	}
+
+	for key := range manifest.receipts {
+		receipt := t.Receipts[key]
+		for p, passenger := range receiptPassengers(receipt) {
+			for l, leg := range passenger.Legs {
+				if !onSection(leg) {
+					continue
+				}
+				entries = append(entries, manifestEntry{
+					ticket: &pb.UserTicket{
+						User:             passenger.User,
+						Seat:             leg.Seat,
+						BookingReference: receipt.BookingReference,
+						From:             leg.From,
+						To:               leg.To,
+						Status:           pb.ManifestStatus_MANIFEST_STATUS_CONFIRMED,
+					},
+					key: manifestKey{
+						LastName:  strings.ToLower(passenger.GetUser().GetLastName()),
+						FirstName: strings.ToLower(passenger.GetUser().GetFirstName()),
+						Seat:      leg.Seat.SeatNumber,
+						Reference: key,
+						Passenger: p,
+						Leg:       l,
+					},
+				})
+			}
+		}
+	}
+
+	for id := range manifest.holds {
+		hold := t.holds[id]
+		if !hold.expiresAt.After(now) {
+			continue
+		}
+		for p, legs := range hold.legs {
+			for l, leg := range legs {
+				if !onSection(leg) {
+					continue
+				}
+				entries = append(entries, manifestEntry{
+					ticket: &pb.UserTicket{
+						Seat:   leg.Seat,
+						From:   leg.From,
+						To:     leg.To,
+						Status: pb.ManifestStatus_MANIFEST_STATUS_HELD,
+						HoldId: id,
+					},
+					key: manifestKey{Seat: leg.Seat.SeatNumber, Reference: id, Passenger: p, Leg: l},
+				})
+			}
+		}
+	}
+	return entries
+}
+
+// manifestPage filters and sorts the entries of a manifest and returns the
+// page after the page token, the token of the next page and the number of
+// entries matching the filters. Callers must hold t.mu.
+func (t *TicketManager) manifestPage(departure *Departure, req *pb.GetUsersBySectionRequest, now time.Time) (*pb.UsersBySectionResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = DefaultManifestPageSize
+	}
+	if pageSize < 0 || pageSize > MaxManifestPageSize {
+		return nil, fmt.Errorf("page size must be between 1 and %d", MaxManifestPageSize)
+	}
+	if _, ok := pb.ManifestSortOrder_name[int32(req.SortBy)]; !ok {
+		return nil, fmt.Errorf("unknown sort order %d", req.SortBy)
+	}
+
+	query := manifestQuery(departure.ID, req)
+	var after *manifestKey
+	if req.PageToken != "" {
+		token, err := decodeManifestPageToken(req.PageToken)
+		if err != nil || token.Query != query {
+			return nil, fmt.Errorf("invalid page token")
+		}
+		after = &token.After
+	}
+
+	entries := []manifestEntry{}
+	for _, entry := range t.manifestEntries(departure, req.Section, now) {
+		if entry.matches(req) {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key.compare(entries[j].key, req.SortBy) < 0
+	})
+
+	// Resume after the last entry of the previous page, so that bookings made
+	// or cancelled between pages neither repeat nor skip entries.
+	start := 0
+	if after != nil {
+		start = sort.Search(len(entries), func(i int) bool {
+			return entries[i].key.compare(*after, req.SortBy) > 0
+		})
+	}
+	end := min(start+pageSize, len(entries))
+
+	response := &pb.UsersBySectionResponse{Users: []*pb.UserTicket{}, TotalSize: int32(len(entries))}
+	for _, entry := range entries[start:end] {
+		response.Users = append(response.Users, entry.ticket)
+	}
+	if end < len(entries) {
+		response.NextPageToken = encodeManifestPageToken(manifestPageToken{Query: query, After: entries[end-1].key})
+	}
+	return response, nil
+}
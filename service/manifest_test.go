@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// manifestPassengers are booked by createManifestTicketManager, in this order.
+var manifestPassengers = []struct {
+	first, last, from, to string
+}{
+	{"Grace", "Hopper", "London", "Paris"},
+	{"Ada", "Lovelace", "London", "Brussels"},
+	{"Alan", "Turing", "Paris", "Brussels"},
+	{"Edsger", "dijkstra", "London", "Paris"},
+	{"Barbara", "Liskov", "London", "Brussels"},
+}
+
+// createManifestTicketManager books manifestPassengers into section A of the
+// London-Paris-Brussels departure "LPB".
+func createManifestTicketManager(t *testing.T) *TicketManager {
+	t.Helper()
+
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 10}}, store), map[string]int64{
+		"London-Paris": 5000, "Paris-Brussels": 2000, "London-Brussels": 6000,
+	}, store)
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID: "LPB", Stops: []string{"London", "Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}, {SectionName: "B", MaxSeats: 10}},
+	})
+	require.NoError(t, err)
+	fill, err := NewAllocationStrategy(FillSectionFirst)
+	require.NoError(t, err)
+	tm.SetAllocationStrategy("", fill)
+
+	for _, p := range manifestPassengers {
+		_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{FirstName: p.first, LastName: p.last, Email: p.first + "@example.com"}, From: p.from, To: p.to, DepartureId: "LPB",
+		})
+		require.NoError(t, err)
+	}
+	return tm
+}
+
+// manifestPages fetches every page of a manifest request from its page token
+// on and returns the entries in order.
+func manifestPages(t *testing.T, tm *TicketManager, req *pb.GetUsersBySectionRequest) []*pb.UserTicket {
+	t.Helper()
+
+	firstPage := req.PageToken == ""
+	entries := []*pb.UserTicket{}
+	for {
+		response, err := tm.GetUsersBySection(context.Background(), req)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(response.Users), int(req.PageSize))
+		entries = append(entries, response.Users...)
+		if response.NextPageToken == "" {
+			if firstPage {
+				assert.Len(t, entries, int(response.TotalSize))
+			}
+			return entries
+		}
+		req.PageToken = response.NextPageToken
+	}
+}
+
+func TestGetUsersBySectionPages(t *testing.T) {
+	tm := createManifestTicketManager(t)
+
+	bySurname := manifestPages(t, tm, &pb.GetUsersBySectionRequest{
+		Section: "A", DepartureId: "LPB", PageSize: 2, SortBy: pb.ManifestSortOrder_MANIFEST_SORT_ORDER_SURNAME,
+	})
+	surnames := []string{}
+	for _, entry := range bySurname {
+		surnames = append(surnames, entry.User.LastName)
+		assert.Equal(t, pb.ManifestStatus_MANIFEST_STATUS_CONFIRMED, entry.Status)
+		assert.NotEmpty(t, entry.BookingReference)
+	}
+	assert.Equal(t, []string{"dijkstra", "Hopper", "Liskov", "Lovelace", "Turing"}, surnames, "Surnames sort ignoring case")
+
+	bySeat := manifestPages(t, tm, &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "LPB", PageSize: 3})
+	require.Len(t, bySeat, 5)
+	for i := 1; i < len(bySeat); i++ {
+		assert.LessOrEqual(t, bySeat[i-1].Seat.SeatNumber, bySeat[i].Seat.SeatNumber)
+	}
+}
+
+func TestGetUsersBySectionFilters(t *testing.T) {
+	tm := createManifestTicketManager(t)
+	hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "Paris", DepartureId: "LPB", SeatCount: 2})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		request       *pb.GetUsersBySectionRequest
+		expectedNames []string
+		expectedCount int
+	}{
+		{name: "Everything", request: &pb.GetUsersBySectionRequest{}, expectedCount: 7},
+		{name: "Booked seats", request: &pb.GetUsersBySectionRequest{Status: pb.ManifestStatus_MANIFEST_STATUS_CONFIRMED}, expectedCount: 5},
+		{name: "Held seats", request: &pb.GetUsersBySectionRequest{Status: pb.ManifestStatus_MANIFEST_STATUS_HELD}, expectedCount: 2},
+		{name: "Boarding at Paris", request: &pb.GetUsersBySectionRequest{From: "Paris"}, expectedNames: []string{"Turing"}},
+		{name: "Travelling London to Brussels", request: &pb.GetUsersBySectionRequest{From: "London", To: "Brussels"}, expectedNames: []string{"Liskov", "Lovelace"}},
+		{name: "Last name prefix", request: &pb.GetUsersBySectionRequest{NamePrefix: "l"}, expectedNames: []string{"Liskov", "Lovelace"}},
+		{name: "First name prefix", request: &pb.GetUsersBySectionRequest{NamePrefix: "ED"}, expectedNames: []string{"dijkstra"}},
+		{name: "No match", request: &pb.GetUsersBySectionRequest{NamePrefix: "Knuth"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.Section = "A"
+			tt.request.DepartureId = "LPB"
+			tt.request.SortBy = pb.ManifestSortOrder_MANIFEST_SORT_ORDER_SURNAME
+			response, err := tm.GetUsersBySection(context.Background(), tt.request)
+			require.NoError(t, err)
+			if tt.expectedNames != nil {
+				names := []string{}
+				for _, entry := range response.Users {
+					names = append(names, entry.User.GetLastName())
+				}
+				assert.Equal(t, tt.expectedNames, names)
+				return
+			}
+			assert.Len(t, response.Users, tt.expectedCount)
+			assert.Equal(t, int32(tt.expectedCount), response.TotalSize)
+		})
+	}
+
+	held, err := tm.GetUsersBySection(context.Background(), &pb.GetUsersBySectionRequest{
+		Section: "A", DepartureId: "LPB", Status: pb.ManifestStatus_MANIFEST_STATUS_HELD,
+	})
+	require.NoError(t, err)
+	for _, entry := range held.Users {
+		assert.Equal(t, hold.HoldId, entry.HoldId)
+		assert.Nil(t, entry.User)
+	}
+
+	// Confirming the hold turns its seats into booked ones.
+	_, err = tm.ConfirmHold(context.Background(), &pb.ConfirmHoldRequest{
+		HoldId: hold.HoldId, Passengers: []*pb.User{
+			{FirstName: "Margaret", LastName: "Hamilton", Email: "margaret@example.com"},
+			{FirstName: "Dennis", LastName: "Ritchie", Email: "dennis@example.com"},
+		},
+	})
+	require.NoError(t, err)
+	confirmed, err := tm.GetUsersBySection(context.Background(), &pb.GetUsersBySectionRequest{
+		Section: "A", DepartureId: "LPB", Status: pb.ManifestStatus_MANIFEST_STATUS_CONFIRMED,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), confirmed.TotalSize)
+	assert.Empty(t, tm.manifests["LPB"]["A"].holds)
+}
+
+func TestGetUsersBySectionPageTokenStable(t *testing.T) {
+	tm := createManifestTicketManager(t)
+	ctx := context.Background()
+	request := &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "LPB", PageSize: 2, SortBy: pb.ManifestSortOrder_MANIFEST_SORT_ORDER_SURNAME}
+
+	first, err := tm.GetUsersBySection(ctx, request)
+	require.NoError(t, err)
+	require.Len(t, first.Users, 2)
+
+	// Cancel a booking already listed and book one that sorts before the
+	// next page.
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: first.Users[0].BookingReference})
+	require.NoError(t, err)
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Frances", LastName: "Allen", Email: "frances@example.com"}, From: "London", To: "Paris", DepartureId: "LPB",
+	})
+	require.NoError(t, err)
+
+	request.PageToken = first.NextPageToken
+	rest := manifestPages(t, tm, request)
+	names := []string{}
+	for _, entry := range rest {
+		names = append(names, entry.User.LastName)
+	}
+	assert.Equal(t, []string{"Liskov", "Lovelace", "Turing"}, names, "Later pages neither repeat nor skip entries")
+}
+
+func TestGetUsersBySectionInvalidRequests(t *testing.T) {
+	tm := createManifestTicketManager(t)
+	ctx := context.Background()
+
+	first, err := tm.GetUsersBySection(ctx, &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "LPB", PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.NextPageToken)
+
+	tests := []struct {
+		name    string
+		request *pb.GetUsersBySectionRequest
+	}{
+		{name: "Page size too large", request: &pb.GetUsersBySectionRequest{PageSize: MaxManifestPageSize + 1}},
+		{name: "Negative page size", request: &pb.GetUsersBySectionRequest{PageSize: -1}},
+		{name: "Unknown sort order", request: &pb.GetUsersBySectionRequest{SortBy: 7}},
+		{name: "Malformed page token", request: &pb.GetUsersBySectionRequest{PageToken: "not a token"}},
+		{name: "Token of another query", request: &pb.GetUsersBySectionRequest{PageToken: first.NextPageToken, NamePrefix: "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.Section = "A"
+			tt.request.DepartureId = "LPB"
+			_, err := tm.GetUsersBySection(ctx, tt.request)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestManifestIndex(t *testing.T) {
+	tm := createManifestTicketManager(t)
+	ctx := context.Background()
+	assert.Len(t, tm.manifests["LPB"]["A"].receipts, 5)
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{LastName: "Knuth", Email: "knuth@example.com"}, From: "London", To: "Paris", DepartureId: "LPB",
+	})
+	require.NoError(t, err)
+	_, err = tm.ModifyUserSeat(ctx, &pb.ModifyUserSeatRequest{
+		BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{Section: "B", SeatNumber: 1},
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, tm.manifests["LPB"]["A"].receipts, receipt.BookingReference)
+	assert.Contains(t, tm.manifests["LPB"]["B"].receipts, receipt.BookingReference)
+
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	assert.Empty(t, tm.manifests["LPB"]["B"].receipts)
+
+	// The index is rebuilt from the stored bookings on restart.
+	restarted := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 10}}, tm.store), nil, tm.store)
+	_, err = restarted.AddDeparture(DepartureConfig{
+		ID: "LPB", Stops: []string{"London", "Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 10}, {SectionName: "B", MaxSeats: 10}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, restarted.Restore())
+	response, err := restarted.GetUsersBySection(ctx, &pb.GetUsersBySectionRequest{Section: "A", DepartureId: "LPB"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), response.TotalSize)
+}
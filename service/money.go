@@ -0,0 +1,271 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultCurrency is the currency fares are priced in unless
+// TicketManager.Currency is changed.
+const DefaultCurrency = "GBP"
+
+// currencyExponents lists the currencies whose minor unit is not a hundredth
+// of the major unit. Every other currency has two decimal places.
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+}
+
+// CurrencyExponent returns the number of decimal places of a currency's minor
+// unit, such as 2 for GBP and 0 for JPY.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// validateCurrency checks that a currency is a three-letter upper-case code.
+func validateCurrency(currency string) error {
+	if len(currency) != 3 {
+		return fmt.Errorf("invalid currency %q", currency)
+	}
+	for _, c := range currency {
+		if c < 'A' || c > 'Z' {
+			return fmt.Errorf("invalid currency %q", currency)
+		}
+	}
+	return nil
+}
+
+// newMoney returns an amount of minor units of a currency.
+func newMoney(currency string, minorUnits int64) *pb.Money {
+	return &pb.Money{CurrencyCode: currency, MinorUnits: minorUnits}
+}
+
+// FormatMoney renders an amount in major units, such as "GBP 12.50".
+func FormatMoney(money *pb.Money) string {
+	exponent := CurrencyExponent(money.GetCurrencyCode())
+	amount := new(big.Rat).SetFrac(big.NewInt(money.GetMinorUnits()), pow10(exponent))
+	return money.GetCurrencyCode() + " " + amount.FloatString(exponent)
+}
+
+// RoundingMode says how an amount that falls between two minor units is
+// rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, halves away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest minor unit, halves to the even one.
+	RoundHalfEven
+	// RoundDown rounds towards zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+// round rounds a rational amount of minor units to a whole number of them.
+func (m RoundingMode) round(amount *big.Rat) int64 {
+	quotient, remainder := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient.Int64()
+	}
+
+	away := false
+	switch m {
+	case RoundUp:
+		away = true
+	case RoundHalfUp, RoundHalfEven:
+		// Compare twice the remainder with the denominator to find which
+		// whole amount is nearer.
+		twice := new(big.Int).Abs(remainder)
+		switch twice.Lsh(twice, 1).Cmp(amount.Denom()) {
+		case 1:
+			away = true
+		case 0:
+			away = m == RoundHalfUp || quotient.Bit(0) == 1
+		}
+	}
+	if away {
+		quotient.Add(quotient, big.NewInt(int64(amount.Sign())))
+	}
+	return quotient.Int64()
+}
+
+// ratio returns a factor such as a multiplier or a distance as the exact
+// decimal it is written as, so 0.7 is seven tenths rather than the nearest
+// binary fraction.
+func ratio(factor float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(factor, 'f', -1, 64))
+	return r
+}
+
+// scaleMinorUnits multiplies an amount of minor units by a factor, rounding
+// halves away from zero.
+func scaleMinorUnits(minorUnits int64, factor *big.Rat) int64 {
+	return RoundHalfUp.round(new(big.Rat).Mul(new(big.Rat).SetInt64(minorUnits), factor))
+}
+
+// pow10 returns ten to the power n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// ExchangeRates converts money between currencies at locally configured
+// rates. A rate set from one currency to another also converts back at its
+// inverse. Converted amounts are rounded by the rounding mode of the target
+// currency, RoundHalfUp unless set.
+type ExchangeRates struct {
+	mu       sync.RWMutex
+	rates    map[string]map[string]*big.Rat
+	rounding map[string]RoundingMode
+}
+
+// NewExchangeRates creates an empty rate table.
+func NewExchangeRates() *ExchangeRates {
+	return &ExchangeRates{
+		rates:    make(map[string]map[string]*big.Rat),
+		rounding: make(map[string]RoundingMode),
+	}
+}
+
+// SetRate sets how many units of currency to one unit of currency from buys,
+// given as a decimal such as "1.17".
+func (r *ExchangeRates) SetRate(from, to, rate string) error {
+	if err := validateCurrency(from); err != nil {
+		return err
+	}
+	if err := validateCurrency(to); err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("rate from %s to itself", from)
+	}
+	value, ok := new(big.Rat).SetString(rate)
+	if !ok || value.Sign() <= 0 {
+		return fmt.Errorf("rate %s to %s: invalid rate %q", from, to, rate)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.rates[from] == nil {
+		r.rates[from] = make(map[string]*big.Rat)
+	}
+	r.rates[from][to] = value
+	return nil
+}
+
+// SetRounding sets how amounts converted into a currency are rounded.
+func (r *ExchangeRates) SetRounding(currency string, mode RoundingMode) error {
+	if err := validateCurrency(currency); err != nil {
+		return err
+	}
+	if mode < RoundHalfUp || mode > RoundUp {
+		return fmt.Errorf("currency %s: invalid rounding mode %d", currency, mode)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.rounding[currency] = mode
+	return nil
+}
+
+// rate returns the rate from one currency to another. Callers must hold r.mu.
+func (r *ExchangeRates) rate(from, to string) (*big.Rat, error) {
+	if from == to {
+		return big.NewRat(1, 1), nil
+	}
+	if rate, ok := r.rates[from][to]; ok {
+		return rate, nil
+	}
+	if rate, ok := r.rates[to][from]; ok {
+		return new(big.Rat).Inv(rate), nil
+	}
+	return nil, fmt.Errorf("no exchange rate from %s to %s", from, to)
+}
+
+// CanConvert returns an error unless money in one currency can be converted
+// into another.
+func (r *ExchangeRates) CanConvert(from, to string) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, err := r.rate(from, to)
+	return err
+}
+
+// Convert converts money into another currency, scaling between the two
+// currencies' minor units and rounding the result to a whole minor unit.
+func (r *ExchangeRates) Convert(money *pb.Money, to string) (*pb.Money, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	from := money.GetCurrencyCode()
+	rate, err := r.rate(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := new(big.Rat).Mul(new(big.Rat).SetInt64(money.GetMinorUnits()), rate)
+	amount.Mul(amount, new(big.Rat).SetFrac(pow10(CurrencyExponent(to)), pow10(CurrencyExponent(from))))
+	return newMoney(to, r.rounding[to].round(amount)), nil
+}
+
+// totalPrice sums the prices of legs in the pricing currency.
+func (t *TicketManager) totalPrice(legs []*pb.Leg) *pb.Money {
+	total := int64(0)
+	for _, leg := range legs {
+		total += leg.Price.GetMinorUnits()
+	}
+	return newMoney(t.Currency, total)
+}
+
+// checkDisplayCurrency checks that prices can be shown in a display currency.
+// An empty currency asks for none.
+func (t *TicketManager) checkDisplayCurrency(currency string) error {
+	if currency == "" {
+		return nil
+	}
+	if err := t.Rates.CanConvert(t.Currency, currency); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// displayPrice converts a price into a display currency, or returns nil when
+// none is asked for.
+func (t *TicketManager) displayPrice(price *pb.Money, currency string) (*pb.Money, error) {
+	if currency == "" {
+		return nil, nil
+	}
+	converted, err := t.Rates.Convert(price, currency)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return converted, nil
+}
+
+// displayReceipt returns a receipt showing its price in a display currency as
+// well, leaving the stored receipt unchanged.
+func (t *TicketManager) displayReceipt(receipt *pb.TicketReceipt, currency string) (*pb.TicketReceipt, error) {
+	price, err := t.displayPrice(receipt.Price, currency)
+	if err != nil || price == nil {
+		return receipt, err
+	}
+	displayed := proto.Clone(receipt).(*pb.TicketReceipt)
+	displayed.DisplayPrice = price
+	return displayed, nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRoundingMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     RoundingMode
+		amount   *big.Rat
+		expected int64
+	}{
+		{name: "Half up rounds halves away from zero", mode: RoundHalfUp, amount: big.NewRat(5, 2), expected: 3},
+		{name: "Half up negative", mode: RoundHalfUp, amount: big.NewRat(-5, 2), expected: -3},
+		{name: "Half up below half", mode: RoundHalfUp, amount: big.NewRat(249, 100), expected: 2},
+		{name: "Half even rounds down to even", mode: RoundHalfEven, amount: big.NewRat(5, 2), expected: 2},
+		{name: "Half even rounds up to even", mode: RoundHalfEven, amount: big.NewRat(7, 2), expected: 4},
+		{name: "Half even above half", mode: RoundHalfEven, amount: big.NewRat(251, 100), expected: 3},
+		{name: "Down truncates", mode: RoundDown, amount: big.NewRat(299, 100), expected: 2},
+		{name: "Down negative", mode: RoundDown, amount: big.NewRat(-299, 100), expected: -2},
+		{name: "Up", mode: RoundUp, amount: big.NewRat(201, 100), expected: 3},
+		{name: "Whole amounts unchanged", mode: RoundUp, amount: big.NewRat(4, 1), expected: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.mode.round(tt.amount))
+		})
+	}
+}
+
+func TestScaleMinorUnits(t *testing.T) {
+	// 0.7 as a float64 is just below seven tenths; scaling must not round
+	// 1050 * 0.7 = 735 down to 734.
+	assert.Equal(t, int64(735), scaleMinorUnits(1050, ratio(0.7)))
+	assert.Equal(t, int64(2904), scaleMinorUnits(2640, ratio(1.1)))
+	assert.Equal(t, int64(1), scaleMinorUnits(1, ratio(0.5)))
+}
+
+func TestExchangeRatesConvert(t *testing.T) {
+	rates := NewExchangeRates()
+	require.NoError(t, rates.SetRate("GBP", "EUR", "1.17"))
+	require.NoError(t, rates.SetRate("GBP", "JPY", "191.5"))
+	require.NoError(t, rates.SetRate("USD", "GBP", "0.8"))
+
+	tests := []struct {
+		name      string
+		money     *pb.Money
+		to        string
+		expected  *pb.Money
+		expectErr bool
+	}{
+		{name: "Direct rate", money: newMoney("GBP", 2000), to: "EUR", expected: newMoney("EUR", 2340)},
+		{name: "Rounded half up", money: newMoney("GBP", 1050), to: "EUR", expected: newMoney("EUR", 1229)},
+		{name: "Inverse rate", money: newMoney("GBP", 2000), to: "USD", expected: newMoney("USD", 2500)},
+		{name: "Into a currency without minor units", money: newMoney("GBP", 2000), to: "JPY", expected: newMoney("JPY", 3830)},
+		{name: "From a currency without minor units", money: newMoney("JPY", 3830), to: "GBP", expected: newMoney("GBP", 2000)},
+		{name: "Same currency", money: newMoney("GBP", 2000), to: "GBP", expected: newMoney("GBP", 2000)},
+		{name: "No rate", money: newMoney("EUR", 2000), to: "USD", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, err := rates.Convert(tt.money, tt.to)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.CurrencyCode, converted.CurrencyCode)
+			assert.Equal(t, tt.expected.MinorUnits, converted.MinorUnits)
+		})
+	}
+
+	// 1050 pence is 1228.5 cents.
+	require.NoError(t, rates.SetRounding("EUR", RoundHalfEven))
+	converted, err := rates.Convert(newMoney("GBP", 1050), "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1228), converted.GetMinorUnits())
+	require.NoError(t, rates.SetRounding("EUR", RoundUp))
+	converted, err = rates.Convert(newMoney("GBP", 1001), "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1172), converted.GetMinorUnits())
+}
+
+func TestExchangeRatesValidation(t *testing.T) {
+	rates := NewExchangeRates()
+
+	assert.Error(t, rates.SetRate("GBP", "eur", "1.17"))
+	assert.Error(t, rates.SetRate("GBP", "EURO", "1.17"))
+	assert.Error(t, rates.SetRate("GBP", "GBP", "1"))
+	assert.Error(t, rates.SetRate("GBP", "EUR", "abc"))
+	assert.Error(t, rates.SetRate("GBP", "EUR", "0"))
+	assert.Error(t, rates.SetRate("GBP", "EUR", "-1.2"))
+	assert.Error(t, rates.SetRounding("EUR", RoundingMode(9)))
+	assert.NoError(t, rates.SetRate("GBP", "EUR", "6/5"))
+}
+
+func TestFormatMoney(t *testing.T) {
+	assert.Equal(t, "GBP 12.50", FormatMoney(newMoney("GBP", 1250)))
+	assert.Equal(t, "GBP -0.05", FormatMoney(newMoney("GBP", -5)))
+	assert.Equal(t, "JPY 3830", FormatMoney(newMoney("JPY", 3830)))
+	assert.Equal(t, "KWD 1.250", FormatMoney(newMoney("KWD", 1250)))
+}
+
+func TestDisplayCurrency(t *testing.T) {
+	tm := createTestTicketManager()
+	require.NoError(t, tm.Rates.SetRate("GBP", "EUR", "1.17"))
+	ctx := context.Background()
+
+	_, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "France", DisplayCurrency: "USD",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, tm.Receipts, "Nothing should be booked")
+
+	receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "France", DisplayCurrency: "EUR",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "GBP", receipt.Price.GetCurrencyCode())
+	assert.Equal(t, int64(2000), receipt.Price.GetMinorUnits())
+	assert.Equal(t, "EUR", receipt.DisplayPrice.GetCurrencyCode())
+	assert.Equal(t, int64(2340), receipt.DisplayPrice.GetMinorUnits())
+	assert.Nil(t, tm.Receipts[receipt.BookingReference].DisplayPrice, "Display price should not be stored")
+
+	fetched, err := tm.GetReceipt(ctx, &pb.GetReceiptRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	assert.Nil(t, fetched.DisplayPrice)
+	fetched, err = tm.GetReceipt(ctx, &pb.GetReceiptRequest{BookingReference: receipt.BookingReference, DisplayCurrency: "EUR"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2340), fetched.DisplayPrice.GetMinorUnits())
+	_, err = tm.GetReceipt(ctx, &pb.GetReceiptRequest{BookingReference: receipt.BookingReference, DisplayCurrency: "USD"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	quote, err := tm.GetPriceQuote(ctx, &pb.GetPriceQuoteRequest{From: "London", To: "France", DisplayCurrency: "EUR"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), quote.Price.GetMinorUnits())
+	assert.Equal(t, int64(2340), quote.DisplayPrice.GetMinorUnits())
+	_, err = tm.GetPriceQuote(ctx, &pb.GetPriceQuoteRequest{From: "London", To: "France", DisplayCurrency: "USD"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
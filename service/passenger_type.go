@@ -0,0 +1,266 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// PassengerType selects the concession fare a passenger travels on.
+type PassengerType string
+
+const (
+	PassengerAdult   PassengerType = "adult"
+	PassengerChild   PassengerType = "child"
+	PassengerSenior  PassengerType = "senior"
+	PassengerStudent PassengerType = "student"
+	// PassengerInfant travels on the lap of a seated passenger and takes no
+	// seat.
+	PassengerInfant PassengerType = "infant"
+)
+
+// passengerTypeProtos maps the API passenger types to passenger types. An
+// unspecified type travels as an adult.
+var passengerTypeProtos = map[pb.PassengerType]PassengerType{
+	pb.PassengerType_PASSENGER_TYPE_UNSPECIFIED: PassengerAdult,
+	pb.PassengerType_PASSENGER_TYPE_ADULT:       PassengerAdult,
+	pb.PassengerType_PASSENGER_TYPE_CHILD:       PassengerChild,
+	pb.PassengerType_PASSENGER_TYPE_SENIOR:      PassengerSenior,
+	pb.PassengerType_PASSENGER_TYPE_STUDENT:     PassengerStudent,
+	pb.PassengerType_PASSENGER_TYPE_INFANT:      PassengerInfant,
+}
+
+// DefaultConcessions are the fare multipliers of each passenger type until
+// changed with SetConcession.
+var DefaultConcessions = map[PassengerType]float64{
+	PassengerAdult:   1,
+	PassengerChild:   0.5,
+	PassengerSenior:  0.7,
+	PassengerStudent: 0.75,
+	PassengerInfant:  0,
+}
+
+// PassengerValidator checks that a passenger may travel as their passenger
+// type on a journey that starts on travelDate.
+type PassengerValidator func(user *pb.User, travelDate time.Time) error
+
+// AgeBetween returns a validator requiring a date of birth that makes the
+// passenger at least min and at most max years old on the travel date.
+func AgeBetween(min, max int) PassengerValidator {
+	return func(user *pb.User, travelDate time.Time) error {
+		age, err := ageOn(user.GetDateOfBirth(), travelDate)
+		if err != nil {
+			return err
+		}
+		if age < min || age > max {
+			return fmt.Errorf("age %d is not between %d and %d", age, min, max)
+		}
+		return nil
+	}
+}
+
+// MinimumAge returns a validator requiring a date of birth that makes the
+// passenger at least min years old on the travel date.
+func MinimumAge(min int) PassengerValidator {
+	return func(user *pb.User, travelDate time.Time) error {
+		age, err := ageOn(user.GetDateOfBirth(), travelDate)
+		if err != nil {
+			return err
+		}
+		if age < min {
+			return fmt.Errorf("age %d is under %d", age, min)
+		}
+		return nil
+	}
+}
+
+// RequireConcessionID is a validator requiring a concession card or ID number.
+func RequireConcessionID(user *pb.User, _ time.Time) error {
+	if user.GetConcessionId() == "" {
+		return fmt.Errorf("a concession ID is required")
+	}
+	return nil
+}
+
+// defaultPassengerValidators returns the checks of each passenger type until
+// changed with SetPassengerValidator.
+func defaultPassengerValidators() map[PassengerType]PassengerValidator {
+	return map[PassengerType]PassengerValidator{
+		PassengerChild:   AgeBetween(2, 15),
+		PassengerSenior:  MinimumAge(60),
+		PassengerStudent: RequireConcessionID,
+		PassengerInfant:  AgeBetween(0, 1),
+	}
+}
+
+// ageOn returns the age in whole years on date of someone born on dateOfBirth,
+// given in YYYY-MM-DD form.
+func ageOn(dateOfBirth string, date time.Time) (int, error) {
+	if dateOfBirth == "" {
+		return 0, fmt.Errorf("a date of birth is required")
+	}
+	born, err := time.Parse(time.DateOnly, dateOfBirth)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date of birth %q", dateOfBirth)
+	}
+
+	year, month, day := date.Date()
+	age := year - born.Year()
+	if month < born.Month() || (month == born.Month() && day < born.Day()) {
+		age--
+	}
+	if age < 0 {
+		return 0, fmt.Errorf("date of birth %s is after the travel date", dateOfBirth)
+	}
+	return age, nil
+}
+
+// passengerTypeOf returns the passenger type of a user.
+func passengerTypeOf(user *pb.User) (PassengerType, error) {
+	passengerType, ok := passengerTypeProtos[user.GetPassengerType()]
+	if !ok {
+		return "", fmt.Errorf("unknown passenger type %v", user.GetPassengerType())
+	}
+	return passengerType, nil
+}
+
+// SetConcession sets the fare multiplier of a passenger type.
+func (t *TicketManager) SetConcession(passengerType PassengerType, multiplier float64) error {
+	if multiplier < 0 {
+		return fmt.Errorf("concession for %s must not be negative", passengerType)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.concessions[passengerType] = multiplier
+	return nil
+}
+
+// SetPassengerValidator sets the check a passenger of a type must pass to
+// book. A nil validator removes the check.
+func (t *TicketManager) SetPassengerValidator(passengerType PassengerType, validator PassengerValidator) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if validator == nil {
+		delete(t.passengerValidators, passengerType)
+		return
+	}
+	t.passengerValidators[passengerType] = validator
+}
+
+// travelDate returns the day a journey on a departure starts: its departure
+// time, else its service date, else now.
+func (d *Departure) travelDate(now time.Time) time.Time {
+	if !d.DepartureTime.IsZero() {
+		return d.DepartureTime
+	}
+	if date, err := time.Parse(time.DateOnly, d.ServiceDate); err == nil {
+		return date
+	}
+	return now
+}
+
+// validatePassengers checks every passenger of a booking against the check of
+// their passenger type. The lead passenger cannot be an infant, and every
+// infant needs a seated adult, senior or student of their own to travel with.
+// Callers must hold t.mu.
+func (t *TicketManager) validatePassengers(passengers []*pb.User, travelDate time.Time) error {
+	infants, carers := 0, 0
+	for i, passenger := range passengers {
+		passengerType, err := passengerTypeOf(passenger)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "passenger %d: %v", i+1, err)
+		}
+		if validator, ok := t.passengerValidators[passengerType]; ok {
+			if err := validator(passenger, travelDate); err != nil {
+				return status.Errorf(codes.InvalidArgument, "passenger %d cannot travel as %s: %v", i+1, passengerType, err)
+			}
+		}
+
+		switch passengerType {
+		case PassengerInfant:
+			infants++
+		case PassengerAdult, PassengerSenior, PassengerStudent:
+			carers++
+		}
+	}
+
+	if lead, _ := passengerTypeOf(passengers[0]); lead == PassengerInfant {
+		return status.Error(codes.InvalidArgument, "the lead passenger cannot be an infant")
+	}
+	if infants > carers {
+		return status.Error(codes.InvalidArgument, "every infant must travel with their own adult")
+	}
+	return nil
+}
+
+// seatedPassengers counts the passengers that need a seat.
+func seatedPassengers(passengers []*pb.User) int {
+	count := 0
+	for _, passenger := range passengers {
+		if passengerType, _ := passengerTypeOf(passenger); passengerType != PassengerInfant {
+			count++
+		}
+	}
+	return count
+}
+
+// passengerLegs gives every passenger of a booking their legs from the legs
+// booked for the seated passengers, in order. Infants get the legs of the lead
+// passenger without a seat, as booked before the lead's concession. Every leg
+// is then priced at the concession of its passenger's type. Callers must hold
+// t.mu.
+func (t *TicketManager) passengerLegs(passengers []*pb.User, booked [][]*pb.Leg) [][]*pb.Leg {
+	lead := make([]*pb.Leg, len(booked[0]))
+	for i, leg := range booked[0] {
+		lead[i] = proto.Clone(leg).(*pb.Leg)
+	}
+
+	legs := make([][]*pb.Leg, len(passengers))
+	seated := 0
+	for p, passenger := range passengers {
+		passengerType, _ := passengerTypeOf(passenger)
+		if passengerType == PassengerInfant {
+			for _, leg := range lead {
+				lap := proto.Clone(leg).(*pb.Leg)
+				lap.Seat = nil
+				lap.SatisfiedPreferences = nil
+				legs[p] = append(legs[p], lap)
+			}
+		} else {
+			legs[p] = booked[seated]
+			seated++
+		}
+
+		for _, leg := range legs[p] {
+			t.applyConcession(leg, passengerType)
+		}
+	}
+	return legs
+}
+
+// applyConcession prices a leg at the concession of a passenger type and
+// records the change as a price adjustment. Callers must hold t.mu.
+func (t *TicketManager) applyConcession(leg *pb.Leg, passengerType PassengerType) {
+	multiplier, ok := t.concessions[passengerType]
+	if !ok || multiplier == 1 {
+		return
+	}
+
+	price := leg.Price.GetMinorUnits()
+	amount := scaleMinorUnits(price, ratio(multiplier)) - price
+	leg.Price = newMoney(leg.Price.GetCurrencyCode(), price+amount)
+	leg.PriceAdjustments = append(leg.PriceAdjustments, &pb.PriceAdjustment{
+		Rule:       fmt.Sprintf("%s concession", passengerType),
+		Multiplier: multiplier,
+		Amount:     newMoney(leg.Price.GetCurrencyCode(), amount),
+		From:       leg.From,
+		To:         leg.To,
+	})
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createPassengerTicketManager returns a manager with a four-seat section A on
+// London-Paris, which costs 2000 pence.
+func createPassengerTicketManager() *TicketManager {
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 4}}
+	return NewTicketManager(NewSeatManager(sections, NewMemoryStore()), map[string]int64{"London-Paris": 2000}, NewMemoryStore())
+}
+
+// bornYearsAgo returns the date of birth of someone who turned the given age
+// today.
+func bornYearsAgo(years int) string {
+	return time.Now().AddDate(-years, 0, 0).Format(time.DateOnly)
+}
+
+func TestAgeOn(t *testing.T) {
+	date := time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		dateOfBirth string
+		expectedAge int
+		expectErr   bool
+	}{
+		{name: "Birthday passed", dateOfBirth: "2000-06-14", expectedAge: 24},
+		{name: "Birthday today", dateOfBirth: "2000-06-15", expectedAge: 24},
+		{name: "Birthday to come", dateOfBirth: "2000-06-16", expectedAge: 23},
+		{name: "Missing date", dateOfBirth: "", expectErr: true},
+		{name: "Invalid date", dateOfBirth: "15/06/2000", expectErr: true},
+		{name: "Born after travel", dateOfBirth: "2024-07-01", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			age, err := ageOn(tt.dateOfBirth, date)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAge, age)
+		})
+	}
+}
+
+func TestPurchaseTicketConcessions(t *testing.T) {
+	tests := []struct {
+		name          string
+		user          *pb.User
+		expectedPrice int64
+		expectedRule  string
+	}{
+		{
+			name:          "Unspecified travels as adult",
+			user:          &pb.User{FirstName: "Ada", Email: "ada@example.com"},
+			expectedPrice: 2000,
+		},
+		{
+			name:          "Child",
+			user:          &pb.User{FirstName: "Ada", Email: "ada@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: bornYearsAgo(8)},
+			expectedPrice: 1000,
+			expectedRule:  "child concession",
+		},
+		{
+			name:          "Senior",
+			user:          &pb.User{FirstName: "Ada", Email: "ada@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_SENIOR, DateOfBirth: bornYearsAgo(70)},
+			expectedPrice: 1400,
+			expectedRule:  "senior concession",
+		},
+		{
+			name:          "Student",
+			user:          &pb.User{FirstName: "Ada", Email: "ada@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_STUDENT, ConcessionId: "NUS-1"},
+			expectedPrice: 1500,
+			expectedRule:  "student concession",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPassengerTicketManager()
+
+			receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{From: "London", To: "Paris", User: tt.user})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedPrice, receipt.Price.GetMinorUnits())
+			assert.Equal(t, tt.expectedPrice, receipt.Legs[0].Price.GetMinorUnits())
+			assert.Equal(t, int64(2000), receipt.Legs[0].BasePrice.GetMinorUnits())
+			if tt.expectedRule == "" {
+				assert.Empty(t, receipt.PriceAdjustments)
+				return
+			}
+			require.Len(t, receipt.PriceAdjustments, 1)
+			assert.Equal(t, tt.expectedRule, receipt.PriceAdjustments[0].Rule)
+			assert.Equal(t, tt.expectedPrice-2000, receipt.PriceAdjustments[0].Amount.GetMinorUnits())
+		})
+	}
+}
+
+func TestPurchaseGroupTicketWithInfant(t *testing.T) {
+	tm := createPassengerTicketManager()
+
+	receipt, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+		From: "London", To: "Paris",
+		Passengers: []*pb.User{
+			{FirstName: "Ada", Email: "ada@example.com"},
+			{FirstName: "Bo", PassengerType: pb.PassengerType_PASSENGER_TYPE_INFANT, DateOfBirth: bornYearsAgo(1)},
+			{FirstName: "Cy", PassengerType: pb.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: bornYearsAgo(5)},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, receipt.Passengers, 3)
+	assert.Equal(t, int64(3000), receipt.Price.GetMinorUnits())
+	infant := receipt.Passengers[1].Legs[0]
+	assert.Nil(t, infant.Seat, "Infant should travel on a lap")
+	assert.Equal(t, int64(0), infant.Price.GetMinorUnits())
+	assert.NotNil(t, receipt.Passengers[2].Legs[0].Seat)
+
+	occupied, _, err := tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+	require.NoError(t, err)
+	assert.Equal(t, 2, occupied, "Infant should not take a seat")
+
+	_, err = tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{BookingReference: receipt.BookingReference, Passenger: 1, NewSeat: &pb.Seat{Section: "A", SeatNumber: 4}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	occupied, _, err = tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+	require.NoError(t, err)
+	assert.Equal(t, 0, occupied)
+}
+
+func TestInfantConcessionNotStacked(t *testing.T) {
+	infant := &pb.User{FirstName: "Bo", PassengerType: pb.PassengerType_PASSENGER_TYPE_INFANT, DateOfBirth: bornYearsAgo(1)}
+
+	tests := []struct {
+		name       string
+		passengers []*pb.User
+	}{
+		{
+			name:       "Adult lead",
+			passengers: []*pb.User{{FirstName: "Ada", Email: "ada@example.com"}, infant},
+		},
+		{
+			name: "Child lead",
+			passengers: []*pb.User{
+				{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: bornYearsAgo(8)},
+				{FirstName: "Ada"},
+				infant,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPassengerTicketManager()
+			require.NoError(t, tm.SetConcession(PassengerInfant, 0.1))
+
+			receipt, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+				From: "London", To: "Paris", Passengers: tt.passengers,
+			})
+			require.NoError(t, err)
+
+			legs := receipt.Passengers[len(tt.passengers)-1].Legs
+			require.Len(t, legs, 1)
+			assert.Equal(t, int64(200), legs[0].Price.GetMinorUnits())
+			require.Len(t, legs[0].PriceAdjustments, 1)
+			assert.Equal(t, "infant concession", legs[0].PriceAdjustments[0].Rule)
+		})
+	}
+}
+
+func TestPassengerValidation(t *testing.T) {
+	adult := &pb.User{FirstName: "Ada", Email: "ada@example.com"}
+	infant := &pb.User{FirstName: "Bo", Email: "bo@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_INFANT, DateOfBirth: bornYearsAgo(1)}
+
+	tests := []struct {
+		name       string
+		passengers []*pb.User
+	}{
+		{name: "Infant alone", passengers: []*pb.User{infant}},
+		{name: "More infants than adults", passengers: []*pb.User{adult, infant, infant}},
+		{name: "Infant with only a child", passengers: []*pb.User{
+			{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: bornYearsAgo(12)},
+			infant,
+		}},
+		{name: "Child too old", passengers: []*pb.User{
+			{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_CHILD, DateOfBirth: bornYearsAgo(30)},
+		}},
+		{name: "Senior without date of birth", passengers: []*pb.User{
+			{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_SENIOR},
+		}},
+		{name: "Student without ID", passengers: []*pb.User{
+			{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_STUDENT},
+		}},
+		{name: "Unknown type", passengers: []*pb.User{
+			{FirstName: "Cy", Email: "cy@example.com", PassengerType: pb.PassengerType(42)},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPassengerTicketManager()
+
+			_, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{From: "London", To: "Paris", Passengers: tt.passengers})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			occupied, _, err := tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+			require.NoError(t, err)
+			assert.Equal(t, 0, occupied, "Rejected booking should not take seats")
+		})
+	}
+}
+
+func TestCustomConcessionAndValidator(t *testing.T) {
+	tm := createPassengerTicketManager()
+	require.NoError(t, tm.SetConcession(PassengerStudent, 0.6))
+	assert.Error(t, tm.SetConcession(PassengerStudent, -1))
+	tm.SetPassengerValidator(PassengerStudent, func(user *pb.User, _ time.Time) error {
+		if user.GetConcessionId() != "VALID" {
+			return fmt.Errorf("unknown student card")
+		}
+		return nil
+	})
+
+	student := &pb.User{FirstName: "Ada", Email: "ada@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_STUDENT, ConcessionId: "OTHER"}
+	_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{From: "London", To: "Paris", User: student})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	student.ConcessionId = "VALID"
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{From: "London", To: "Paris", User: student})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1200), receipt.Price.GetMinorUnits())
+
+	// Without a validator any student may book.
+	tm.SetPassengerValidator(PassengerStudent, nil)
+	student = &pb.User{FirstName: "Bo", Email: "bo@example.com", PassengerType: pb.PassengerType_PASSENGER_TYPE_STUDENT}
+	_, err = tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{From: "London", To: "Paris", User: student})
+	assert.NoError(t, err)
+}
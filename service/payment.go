@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultPaymentTimeout bounds how long a payment authorization may take
+// unless TicketManager.PaymentTimeout is changed.
+const DefaultPaymentTimeout = 10 * time.Second
+
+// ErrPaymentDeclined is returned by a PaymentProvider that refuses to
+// authorize a payment.
+var ErrPaymentDeclined = errors.New("payment declined")
+
+// PaymentRequest is a payment to authorize.
+type PaymentRequest struct {
+	// Reference is the booking reference the payment is for.
+	Reference string
+	Amount    *pb.Money
+	// Token identifies the card or account to charge.
+	Token string
+}
+
+// PaymentProvider takes payments in two phases: an authorization reserves the
+// amount, and a capture collects it or a void gives it up. Captured payments
+// can be refunded in part or in full.
+type PaymentProvider interface {
+	// Authorize reserves a payment and returns its ID. It returns
+	// ErrPaymentDeclined when the payment is refused.
+	Authorize(ctx context.Context, req PaymentRequest) (string, error)
+	// Capture collects an authorized payment.
+	Capture(ctx context.Context, paymentID string) error
+	// Void releases an authorized payment that has not been captured.
+	Void(ctx context.Context, paymentID string) error
+	// Refund pays back up to the captured amount of a payment.
+	Refund(ctx context.Context, paymentID string, amount *pb.Money) error
+}
+
+// PaymentState is the stage a payment of a FakePaymentProvider is at.
+type PaymentState string
+
+const (
+	PaymentAuthorized PaymentState = "authorized"
+	PaymentCaptured   PaymentState = "captured"
+	PaymentVoided     PaymentState = "voided"
+)
+
+// FakeDeclinedToken is a payment token the FakePaymentProvider declines.
+const FakeDeclinedToken = "tok_declined"
+
+// FakePayment is a payment taken by a FakePaymentProvider.
+type FakePayment struct {
+	ID        string
+	Reference string
+	Token     string
+	Amount    *pb.Money
+	State     PaymentState
+	// Refunded is the amount paid back, in minor units.
+	Refunded int64
+}
+
+// FakePaymentProvider is an in-memory PaymentProvider for local runs and
+// tests. It authorizes every token except FakeDeclinedToken.
+type FakePaymentProvider struct {
+	mu       sync.Mutex
+	payments map[string]*FakePayment
+	next     int
+	// AuthorizeDelay makes every authorization take this long, to try out
+	// payment timeouts.
+	AuthorizeDelay time.Duration
+	// FailCapture makes captures fail.
+	FailCapture bool
+}
+
+// NewFakePaymentProvider creates a fake provider without payments.
+func NewFakePaymentProvider() *FakePaymentProvider {
+	return &FakePaymentProvider{payments: make(map[string]*FakePayment)}
+}
+
+// Authorize reserves a payment unless its token is FakeDeclinedToken.
+func (f *FakePaymentProvider) Authorize(ctx context.Context, req PaymentRequest) (string, error) {
+	if f.AuthorizeDelay > 0 {
+		select {
+		case <-time.After(f.AuthorizeDelay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+	if req.Token == FakeDeclinedToken {
+		return "", ErrPaymentDeclined
+	}
+	if req.Amount.GetMinorUnits() <= 0 {
+		return "", fmt.Errorf("invalid payment amount %d", req.Amount.GetMinorUnits())
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.next++
+	id := fmt.Sprintf("pay_%d", f.next)
+	f.payments[id] = &FakePayment{
+		ID:        id,
+		Reference: req.Reference,
+		Token:     req.Token,
+		Amount:    req.Amount,
+		State:     PaymentAuthorized,
+	}
+	return id, nil
+}
+
+// Capture collects an authorized payment.
+func (f *FakePaymentProvider) Capture(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, err := f.payment(paymentID, PaymentAuthorized)
+	if err != nil {
+		return err
+	}
+	if f.FailCapture {
+		return fmt.Errorf("payment %s: capture failed", paymentID)
+	}
+	payment.State = PaymentCaptured
+	return nil
+}
+
+// Void releases an authorized payment.
+func (f *FakePaymentProvider) Void(ctx context.Context, paymentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, err := f.payment(paymentID, PaymentAuthorized)
+	if err != nil {
+		return err
+	}
+	payment.State = PaymentVoided
+	return nil
+}
+
+// Refund pays back part of a captured payment.
+func (f *FakePaymentProvider) Refund(ctx context.Context, paymentID string, amount *pb.Money) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, err := f.payment(paymentID, PaymentCaptured)
+	if err != nil {
+		return err
+	}
+	if amount.GetCurrencyCode() != payment.Amount.GetCurrencyCode() {
+		return fmt.Errorf("payment %s: refund in %s of a payment in %s", paymentID, amount.GetCurrencyCode(), payment.Amount.GetCurrencyCode())
+	}
+	if amount.GetMinorUnits() <= 0 || payment.Refunded+amount.GetMinorUnits() > payment.Amount.GetMinorUnits() {
+		return fmt.Errorf("payment %s: invalid refund amount %d", paymentID, amount.GetMinorUnits())
+	}
+	payment.Refunded += amount.GetMinorUnits()
+	return nil
+}
+
+// Payment returns a copy of a payment.
+func (f *FakePaymentProvider) Payment(paymentID string) (FakePayment, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	payment, ok := f.payments[paymentID]
+	if !ok {
+		return FakePayment{}, false
+	}
+	return *payment, true
+}
+
+// payment returns a payment that must be in the given state. Callers must
+// hold f.mu.
+func (f *FakePaymentProvider) payment(paymentID string, state PaymentState) (*FakePayment, error) {
+	payment, ok := f.payments[paymentID]
+	if !ok {
+		return nil, fmt.Errorf("payment %s not found", paymentID)
+	}
+	if payment.State != state {
+		return nil, fmt.Errorf("payment %s is %s, not %s", paymentID, payment.State, state)
+	}
+	return payment, nil
+}
+
+// unlocked runs f with t.mu released, so that a slow payment provider does
+// not hold up other requests. State read before f may have changed after it.
+// Callers must hold t.mu.
+func (t *TicketManager) unlocked(f func()) {
+	t.mu.Unlock()
+	defer t.mu.Lock()
+	f()
+}
+
+// payForReceipt takes payment for a receipt and sets its payment ID. The
+// seats must already be taken. While t.mu is released for the payment, the
+// receipt is pending, which keeps its booking reference and promo code uses
+// taken. Callers must hold t.mu.
+func (t *TicketManager) payForReceipt(ctx context.Context, receipt *pb.TicketReceipt, token string) error {
+	t.pending[receipt.BookingReference] = receipt
+	defer delete(t.pending, receipt.BookingReference)
+
+	var paymentID string
+	var err error
+	t.unlocked(func() {
+		paymentID, err = t.collectPayment(ctx, receipt.BookingReference, receipt.Price, token)
+	})
+	if err != nil {
+		return err
+	}
+	receipt.PaymentId = paymentID
+	return nil
+}
+
+// collectPayment authorizes and captures the price of a booking and returns
+// the payment ID, or an empty ID when there is no provider or nothing to pay.
+// Authorization must finish within t.PaymentTimeout. A payment that
+// cannot be captured is voided. It is called without t.mu.
+func (t *TicketManager) collectPayment(ctx context.Context, reference string, price *pb.Money, token string) (string, error) {
+	if t.Payments == nil || price.GetMinorUnits() <= 0 {
+		return "", nil
+	}
+
+	authorizeCtx, cancel := context.WithTimeout(ctx, t.PaymentTimeout)
+	defer cancel()
+
+	paymentID, err := t.Payments.Authorize(authorizeCtx, PaymentRequest{Reference: reference, Amount: price, Token: token})
+	switch {
+	case errors.Is(err, ErrPaymentDeclined):
+		return "", status.Error(codes.FailedPrecondition, "payment declined")
+	case errors.Is(err, context.DeadlineExceeded):
+		return "", status.Error(codes.DeadlineExceeded, "payment authorization timed out")
+	case err != nil:
+		log.Printf("Payment authorization failed for %s: %v", reference, err)
+		return "", status.Error(codes.Unavailable, "payment failed")
+	}
+
+	if err := t.Payments.Capture(ctx, paymentID); err != nil {
+		log.Printf("Payment capture failed for %s: %v", reference, err)
+		if voidErr := t.Payments.Void(context.WithoutCancel(ctx), paymentID); voidErr != nil {
+			log.Printf("Payment void failed for %s: %v", reference, voidErr)
+		}
+		return "", status.Error(codes.Unavailable, "payment failed")
+	}
+	return paymentID, nil
+}
+
+// isPaymentFailure reports whether err is one of the errors collectPayment
+// returns when the payment is not taken.
+func isPaymentFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.FailedPrecondition, codes.DeadlineExceeded, codes.Unavailable:
+		return true
+	}
+	return false
+}
+
+// refundPayment pays back a captured payment whose booking could not be
+// saved, with t.mu released. Callers must hold t.mu.
+func (t *TicketManager) refundPayment(ctx context.Context, paymentID string, amount *pb.Money) {
+	if paymentID == "" {
+		return
+	}
+	var err error
+	t.unlocked(func() {
+		err = t.Payments.Refund(context.WithoutCancel(ctx), paymentID, amount)
+	})
+	if err != nil {
+		log.Printf("Payment refund failed for %s: %v", paymentID, err)
+	}
+}
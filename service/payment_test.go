@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFakePaymentProvider(t *testing.T) {
+	ctx := context.Background()
+	provider := NewFakePaymentProvider()
+
+	_, err := provider.Authorize(ctx, PaymentRequest{Amount: newMoney("GBP", 2000), Token: FakeDeclinedToken})
+	assert.ErrorIs(t, err, ErrPaymentDeclined)
+	_, err = provider.Authorize(ctx, PaymentRequest{Amount: newMoney("GBP", 0), Token: "tok_visa"})
+	assert.Error(t, err)
+
+	id, err := provider.Authorize(ctx, PaymentRequest{Reference: "ABC123", Amount: newMoney("GBP", 2000), Token: "tok_visa"})
+	require.NoError(t, err)
+	assert.Error(t, provider.Refund(ctx, id, newMoney("GBP", 500)), "Uncaptured payments cannot be refunded")
+	require.NoError(t, provider.Capture(ctx, id))
+	assert.Error(t, provider.Capture(ctx, id), "Payments are captured once")
+	assert.Error(t, provider.Void(ctx, id), "Captured payments cannot be voided")
+
+	require.NoError(t, provider.Refund(ctx, id, newMoney("GBP", 500)))
+	assert.Error(t, provider.Refund(ctx, id, newMoney("GBP", 1600)), "Refunds cannot exceed the payment")
+	assert.Error(t, provider.Refund(ctx, id, newMoney("EUR", 100)))
+	require.NoError(t, provider.Refund(ctx, id, newMoney("GBP", 1500)))
+
+	payment, ok := provider.Payment(id)
+	require.True(t, ok)
+	assert.Equal(t, PaymentCaptured, payment.State)
+	assert.Equal(t, int64(2000), payment.Refunded)
+	assert.Equal(t, "ABC123", payment.Reference)
+
+	voided, err := provider.Authorize(ctx, PaymentRequest{Amount: newMoney("GBP", 2000), Token: "tok_visa"})
+	require.NoError(t, err)
+	require.NoError(t, provider.Void(ctx, voided))
+	assert.Error(t, provider.Capture(ctx, voided))
+}
+
+func TestPurchaseTicketPayment(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		setup         func(tm *TicketManager, provider *FakePaymentProvider)
+		expectedCode  codes.Code
+		expectedState PaymentState
+	}{
+		{name: "Captured", token: "tok_visa", expectedCode: codes.OK, expectedState: PaymentCaptured},
+		{name: "Declined", token: FakeDeclinedToken, expectedCode: codes.FailedPrecondition},
+		{
+			name:  "Authorization times out",
+			token: "tok_visa",
+			setup: func(tm *TicketManager, provider *FakePaymentProvider) {
+				provider.AuthorizeDelay = time.Second
+				tm.PaymentTimeout = 10 * time.Millisecond
+			},
+			expectedCode: codes.DeadlineExceeded,
+		},
+		{
+			name:  "Capture fails",
+			token: "tok_visa",
+			setup: func(tm *TicketManager, provider *FakePaymentProvider) {
+				provider.FailCapture = true
+			},
+			expectedCode:  codes.Unavailable,
+			expectedState: PaymentVoided,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPassengerTicketManager()
+			provider := NewFakePaymentProvider()
+			tm.Payments = provider
+			if tt.setup != nil {
+				tt.setup(tm, provider)
+			}
+
+			receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+				User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: tt.token,
+			})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			payment, paid := provider.Payment("pay_1")
+			if tt.expectedState != "" {
+				require.True(t, paid)
+				assert.Equal(t, tt.expectedState, payment.State)
+			} else {
+				assert.False(t, paid)
+			}
+
+			occupied, _, occupancyErr := tm.SeatManager.ClassOccupancy("London", "Paris", FareClassStandard)
+			require.NoError(t, occupancyErr)
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, 0, occupied, "Seat should be released")
+				assert.Empty(t, tm.Receipts)
+				return
+			}
+			assert.Equal(t, 1, occupied)
+			assert.Equal(t, "pay_1", receipt.PaymentId)
+			assert.Equal(t, receipt.Price.GetMinorUnits(), payment.Amount.GetMinorUnits())
+			assert.Equal(t, receipt.BookingReference, payment.Reference)
+		})
+	}
+}
+
+func TestPurchaseTicketPaymentRefundedWhenNotSaved(t *testing.T) {
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 4}}
+	tm := NewTicketManager(NewSeatManager(sections, NewMemoryStore()), map[string]int64{"London-Paris": 2000}, failingStore{})
+	provider := NewFakePaymentProvider()
+	tm.Payments = provider
+
+	_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	payment, ok := provider.Payment("pay_1")
+	require.True(t, ok)
+	assert.Equal(t, int64(2000), payment.Refunded)
+}
+
+func TestConfirmHoldPayment(t *testing.T) {
+	tm := createPassengerTicketManager()
+	tm.Payments = NewFakePaymentProvider()
+	ctx := context.Background()
+
+	hold, err := tm.HoldSeats(ctx, &pb.HoldSeatsRequest{From: "London", To: "Paris", SeatCount: 1})
+	require.NoError(t, err)
+
+	_, err = tm.ConfirmHold(ctx, &pb.ConfirmHoldRequest{
+		HoldId: hold.HoldId, Passengers: []*pb.User{{FirstName: "Ada", Email: "ada@example.com"}}, PaymentToken: FakeDeclinedToken,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, tm.holds, hold.HoldId, "Declined payment should keep the hold")
+	assert.Equal(t, "Held", tm.SeatManager.Sections["A"].SeatState(int(hold.Seats[0].Legs[0].Seat.SeatNumber)))
+
+	receipt, err := tm.ConfirmHold(ctx, &pb.ConfirmHoldRequest{
+		HoldId: hold.HoldId, Passengers: []*pb.User{{FirstName: "Ada", Email: "ada@example.com"}}, PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hold.Seats[0].Legs[0].Seat.SeatNumber, receipt.Seat.SeatNumber)
+	assert.NotEmpty(t, receipt.PaymentId)
+}
+
+// blockingPaymentProvider signals on authorizing when it is asked to
+// authorize the token "tok_slow", and only goes on once release is closed.
+type blockingPaymentProvider struct {
+	*FakePaymentProvider
+	authorizing chan struct{}
+	release     chan struct{}
+}
+
+func (p blockingPaymentProvider) Authorize(ctx context.Context, req PaymentRequest) (string, error) {
+	if req.Token == "tok_slow" {
+		p.authorizing <- struct{}{}
+		<-p.release
+	}
+	return p.FakePaymentProvider.Authorize(ctx, req)
+}
+
+func TestPaymentDoesNotBlockOtherRequests(t *testing.T) {
+	tm := createPassengerTicketManager()
+	provider := blockingPaymentProvider{NewFakePaymentProvider(), make(chan struct{}), make(chan struct{})}
+	tm.Payments = provider
+	ctx := context.Background()
+
+	purchased := make(chan error)
+	go func() {
+		_, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+			User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_slow",
+		})
+		purchased <- err
+	}()
+	<-provider.authorizing
+
+	// Another booking goes ahead while the first is being paid for, and
+	// does not get its seat.
+	other := make(chan *pb.TicketReceipt)
+	go func() {
+		receipt, err := tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+			User: &pb.User{FirstName: "Bo", Email: "bo@example.com"}, From: "London", To: "Paris", PaymentToken: "tok_visa",
+		})
+		assert.NoError(t, err)
+		other <- receipt
+	}()
+	var receipt *pb.TicketReceipt
+	select {
+	case receipt = <-other:
+	case <-time.After(5 * time.Second):
+		t.Fatal("A payment in progress blocked another request")
+	}
+
+	close(provider.release)
+	require.NoError(t, <-purchased)
+	first, err := tm.GetReceipt(ctx, &pb.GetReceiptRequest{Email: "ada@example.com"})
+	require.NoError(t, err)
+	assert.NotEqual(t, receipt.Seat.SeatNumber, first.Seat.SeatNumber)
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultQuoteTTL is how long a price quote stays locked.
+const DefaultQuoteTTL = 5 * time.Minute
+
+// priceQuote is a journey priced for one seat in a fare class. Its legs have
+// no seats; they record the departure and price of each leg.
+type priceQuote struct {
+	booking
+	// departureID is the departure requested, empty for the default one.
+	departureID string
+	legs        []*pb.Leg
+	expiresAt   time.Time
+}
+
+// priceLeg prices one seat in a fare class on a leg of a departure at the
+// given time: the class fare adjusted by the pricing engine. The returned leg
+// has no seat.
+func (t *TicketManager) priceLeg(departure *Departure, leg JourneyLeg, class string, now time.Time) *pb.Leg {
+	base := t.Routes.ClassFare(class, leg)
+	// The departure serves the leg, so the range is valid; were it not, the
+	// fare would simply not be adjusted for occupancy.
+	occupied, capacity, _ := departure.SeatManager.ClassOccupancy(leg.From, leg.To, class)
+	price, adjustments := t.Pricing.Price(base, PricingConditions{
+		Occupied:      occupied,
+		Capacity:      capacity,
+		DepartureTime: departure.DepartureTime,
+		Now:           now,
+	})
+
+	priced := &pb.Leg{
+		From:        leg.From,
+		To:          leg.To,
+		DepartureId: departure.ID,
+		Price:       newMoney(t.Currency, price),
+		FareClass:   class,
+		BasePrice:   newMoney(t.Currency, base),
+	}
+	for _, adjustment := range adjustments {
+		priced.PriceAdjustments = append(priced.PriceAdjustments, &pb.PriceAdjustment{
+			Rule:       adjustment.Rule,
+			Multiplier: adjustment.Multiplier,
+			Amount:     newMoney(t.Currency, adjustment.Amount),
+			From:       leg.From,
+			To:         leg.To,
+		})
+	}
+	return priced
+}
+
+// priceAdjustments returns copies of the price adjustments of every leg.
+func priceAdjustments(legs []*pb.Leg) []*pb.PriceAdjustment {
+	adjustments := []*pb.PriceAdjustment{}
+	for _, leg := range legs {
+		for _, adjustment := range leg.PriceAdjustments {
+			adjustments = append(adjustments, proto.Clone(adjustment).(*pb.PriceAdjustment))
+		}
+	}
+	return adjustments
+}
+
+// GetPriceQuote prices one seat on a journey and locks the price for
+// DefaultQuoteTTL. Purchases that pass the quote ID are charged the quoted
+// price, however the fare moves in the meantime.
+func (t *TicketManager) GetPriceQuote(ctx context.Context, req *pb.GetPriceQuoteRequest) (*pb.PriceQuote, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("GetPriceQuote request received: %+v", req)
+
+	if req.From == "" || req.To == "" {
+		log.Printf("GetPriceQuote request missing required fields: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("GetPriceQuote request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+
+	journey, err := t.Routes.FindJourney(req.From, req.To)
+	if err != nil {
+		log.Printf("GetPriceQuote request with invalid station: From=%s, To=%s", req.From, req.To)
+		return nil, status.Error(codes.InvalidArgument, "invalid station")
+	}
+
+	departure, ok := t.departure(req.DepartureId)
+	if !ok {
+		log.Printf("GetPriceQuote request with unknown departure: %s", req.DepartureId)
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+
+	if err := t.checkDisplayCurrency(req.DisplayCurrency); err != nil {
+		log.Printf("GetPriceQuote request with unknown display currency: %s", req.DisplayCurrency)
+		return nil, err
+	}
+
+	// Legs are priced on the departures a purchase would book them on.
+	quote := &priceQuote{
+		booking:     booking{from: req.From, to: req.To, fareClass: fareClassOrDefault(req.FareClass)},
+		departureID: req.DepartureId,
+	}
+	now := time.Now()
+	var after time.Time
+	for i, leg := range journey {
+		legDeparture, err := t.legDeparture(departure, req.DepartureId != "" && i == 0, leg, after)
+		if err != nil {
+			log.Printf("GetPriceQuote departure lookup failed: %v", err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		quote.legs = append(quote.legs, t.priceLeg(legDeparture, leg, quote.fareClass, now))
+		after = legDeparture.DepartureTime
+	}
+
+	id, err := newReference(func(id string) bool {
+		_, taken := t.quotes["QUOTE-"+id]
+		return taken
+	})
+	if err != nil {
+		log.Printf("GetPriceQuote quote id failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create quote")
+	}
+	id = "QUOTE-" + id
+	quote.expiresAt = now.Add(DefaultQuoteTTL)
+	t.quotes[id] = quote
+
+	response := &pb.PriceQuote{
+		QuoteId:     id,
+		From:        quote.from,
+		To:          quote.to,
+		DepartureId: departure.ID,
+		FareClass:   quote.fareClass,
+		ExpiresAt:   timestamppb.New(quote.expiresAt),
+	}
+	for _, leg := range quote.legs {
+		response.Legs = append(response.Legs, proto.Clone(leg).(*pb.Leg))
+	}
+	response.Price = t.totalPrice(quote.legs)
+	// The display currency was checked above, so the conversion succeeds.
+	response.DisplayPrice, _ = t.displayPrice(response.Price, req.DisplayCurrency)
+
+	log.Printf("GetPriceQuote successful: %+v", response)
+	return response, nil
+}
+
+// lockedQuote returns the unexpired quote for a booking on the requested
+// departure. Callers must hold t.mu.
+func (t *TicketManager) lockedQuote(id string, b booking, departureID string) (*priceQuote, error) {
+	quote, ok := t.quotes[id]
+	if !ok {
+		log.Printf("PurchaseTicket quote not found: %s", id)
+		return nil, status.Error(codes.NotFound, "quote not found")
+	}
+	if !time.Now().Before(quote.expiresAt) {
+		log.Printf("PurchaseTicket quote expired: %s", id)
+		delete(t.quotes, id)
+		return nil, status.Error(codes.FailedPrecondition, "quote has expired")
+	}
+	if quote.from != b.from || quote.to != b.to || quote.fareClass != b.fareClass || quote.departureID != departureID {
+		log.Printf("PurchaseTicket quote %s is for another journey", id)
+		return nil, status.Error(codes.InvalidArgument, "quote is for another journey")
+	}
+	return quote, nil
+}
+
+// apply charges every passenger's legs at the quoted prices. It fails when a
+// leg was booked on another departure than the one quoted.
+func (q *priceQuote) apply(booked [][]*pb.Leg) error {
+	for _, legs := range booked {
+		if len(legs) != len(q.legs) {
+			return status.Error(codes.FailedPrecondition, "quote no longer matches the journey")
+		}
+		for i, leg := range legs {
+			quoted := q.legs[i]
+			if leg.From != quoted.From || leg.To != quoted.To || leg.DepartureId != quoted.DepartureId {
+				return status.Error(codes.FailedPrecondition, "quote no longer matches the journey")
+			}
+			leg.Price = quoted.Price
+			leg.BasePrice = quoted.BasePrice
+			leg.PriceAdjustments = priceAdjustments([]*pb.Leg{quoted})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createPricingTicketManager returns a manager with one four-seat section on
+// London-Paris at 20, whose fares rise by half once half the seats are taken.
+func createPricingTicketManager(t *testing.T) *TicketManager {
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 4}}, NewMemoryStore()),
+		map[string]int64{"London-Paris": 2000}, NewMemoryStore())
+	require.NoError(t, tm.Pricing.SetRules(PricingRules{Occupancy: []OccupancyBand{{MinPercent: 50, Multiplier: 1.5}}}))
+	return tm
+}
+
+// buyTicket purchases a London-Paris ticket for a numbered passenger.
+func buyTicket(t *testing.T, tm *TicketManager, n int, quoteID string) (*pb.TicketReceipt, error) {
+	return tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User:    &pb.User{FirstName: "Passenger", Email: fmt.Sprintf("p%d@example.com", n)},
+		From:    "London",
+		To:      "Paris",
+		QuoteId: quoteID,
+	})
+}
+
+func TestPurchaseTicketDynamicPrice(t *testing.T) {
+	tm := createPricingTicketManager(t)
+
+	expected := []int64{2000, 2000, 3000, 3000}
+	for n, price := range expected {
+		receipt, err := buyTicket(t, tm, n, "")
+		require.NoError(t, err)
+		assert.Equal(t, price, receipt.Price.GetMinorUnits(), "Ticket %d", n)
+		assert.Equal(t, int64(2000), receipt.Legs[0].BasePrice.GetMinorUnits())
+
+		if price == 2000 {
+			assert.Empty(t, receipt.PriceAdjustments)
+			continue
+		}
+		require.Len(t, receipt.PriceAdjustments, 1)
+		adjustment := receipt.PriceAdjustments[0]
+		assert.Equal(t, "occupancy >= 50%", adjustment.Rule)
+		assert.Equal(t, 1.5, adjustment.Multiplier)
+		assert.Equal(t, int64(1000), adjustment.Amount.GetMinorUnits())
+		assert.Equal(t, "London", adjustment.From)
+		assert.Equal(t, receipt.Legs[0].PriceAdjustments[0].Rule, adjustment.Rule)
+	}
+}
+
+func TestPurchaseTicketBookingWindow(t *testing.T) {
+	tm := createPricingTicketManager(t)
+	require.NoError(t, tm.Pricing.SetRules(PricingRules{BookingWindow: []BookingWindowBand{{Within: 24 * time.Hour, Multiplier: 1.25}}}))
+
+	for id, departs := range map[string]time.Duration{"SOON": 2 * time.Hour, "LATER": 72 * time.Hour} {
+		_, err := tm.AddDeparture(DepartureConfig{
+			ID: id, DepartureTime: time.Now().Add(departs),
+			Sections: []SectionConfigs{{SectionName: "A", MaxSeats: 4}},
+		})
+		require.NoError(t, err)
+	}
+
+	soon, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "soon@example.com"}, From: "London", To: "Paris", DepartureId: "SOON",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2500), soon.Price.GetMinorUnits())
+
+	later, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "later@example.com"}, From: "London", To: "Paris", DepartureId: "LATER",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), later.Price.GetMinorUnits())
+}
+
+func TestGetPriceQuote(t *testing.T) {
+	tm := createPricingTicketManager(t)
+
+	quote, err := tm.GetPriceQuote(context.Background(), &pb.GetPriceQuoteRequest{From: "London", To: "Paris"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), quote.Price.GetMinorUnits())
+	assert.Equal(t, FareClassStandard, quote.FareClass)
+	assert.Equal(t, DefaultDepartureID, quote.DepartureId)
+	require.Len(t, quote.Legs, 1)
+	assert.Nil(t, quote.Legs[0].Seat)
+	assert.WithinDuration(t, time.Now().Add(DefaultQuoteTTL), quote.ExpiresAt.AsTime(), time.Minute)
+
+	// The train fills up, but the quote keeps its price.
+	for n := 0; n < 2; n++ {
+		_, err := buyTicket(t, tm, n, "")
+		require.NoError(t, err)
+	}
+	receipt, err := buyTicket(t, tm, 2, quote.QuoteId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), receipt.Price.GetMinorUnits())
+	assert.Empty(t, receipt.PriceAdjustments)
+
+	// A quote is used once.
+	_, err = buyTicket(t, tm, 3, quote.QuoteId)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetPriceQuoteRejected(t *testing.T) {
+	tests := []struct {
+		name         string
+		prepare      func(tm *TicketManager, quoteID string)
+		request      *pb.PurchaseTicketRequest
+		expectedCode codes.Code
+	}{
+		{
+			name:         "Another journey",
+			request:      &pb.PurchaseTicketRequest{From: "London", To: "Paris", FareClass: FareClassFirst},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Expired",
+			prepare: func(tm *TicketManager, quoteID string) {
+				tm.quotes[quoteID].expiresAt = time.Now().Add(-time.Second)
+			},
+			request:      &pb.PurchaseTicketRequest{From: "London", To: "Paris"},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "Swept",
+			prepare: func(tm *TicketManager, quoteID string) {
+				tm.sweepHolds(time.Now().Add(DefaultQuoteTTL))
+			},
+			request:      &pb.PurchaseTicketRequest{From: "London", To: "Paris"},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPricingTicketManager(t)
+			quote, err := tm.GetPriceQuote(context.Background(), &pb.GetPriceQuoteRequest{From: "London", To: "Paris"})
+			require.NoError(t, err)
+			if tt.prepare != nil {
+				tt.prepare(tm, quote.QuoteId)
+			}
+
+			tt.request.User = &pb.User{Email: "quote@example.com"}
+			tt.request.QuoteId = quote.QuoteId
+			_, err = tm.PurchaseTicket(context.Background(), tt.request)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, "Available", tm.SeatManager.Sections["A"].SeatState(1), "No seat should be taken")
+		})
+	}
+}
+
+func TestPurchaseGroupTicketWithQuote(t *testing.T) {
+	tm := createPricingTicketManager(t)
+
+	quote, err := tm.GetPriceQuote(context.Background(), &pb.GetPriceQuoteRequest{From: "London", To: "Paris"})
+	require.NoError(t, err)
+	_, err = buyTicket(t, tm, 0, "")
+	require.NoError(t, err)
+	_, err = buyTicket(t, tm, 1, "")
+	require.NoError(t, err)
+
+	receipt, err := tm.PurchaseGroupTicket(context.Background(), &pb.PurchaseGroupTicketRequest{
+		From:       "London",
+		To:         "Paris",
+		Passengers: []*pb.User{{FirstName: "Ada", Email: "ada@example.com"}, {FirstName: "Charles"}},
+		QuoteId:    quote.QuoteId,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4000), receipt.Price.GetMinorUnits())
+	for _, passenger := range receipt.Passengers {
+		assert.Equal(t, int64(2000), passenger.Legs[0].Price.GetMinorUnits())
+	}
+}
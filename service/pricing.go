@@ -0,0 +1,178 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OccupancyBand raises or lowers fares once at least MinPercent of a fare
+// class's seats are taken on the journey.
+type OccupancyBand struct {
+	MinPercent float64
+	Multiplier float64
+}
+
+// BookingWindowBand raises or lowers fares booked Within the given time before
+// departure.
+type BookingWindowBand struct {
+	Within     time.Duration
+	Multiplier float64
+}
+
+// PricingRules are the rule tables of a PricingEngine. Of each table at most
+// one entry applies to a fare: the occupancy band with the highest MinPercent
+// reached, the booking window band with the shortest Within that the time to
+// departure falls in, and the multiplier for the departure's day of week.
+type PricingRules struct {
+	Occupancy     []OccupancyBand
+	BookingWindow []BookingWindowBand
+	DayOfWeek     map[time.Weekday]float64
+}
+
+// validate checks that every multiplier is positive and that no table has two
+// entries for the same band.
+func (r PricingRules) validate() error {
+	percents := make(map[float64]bool)
+	for _, band := range r.Occupancy {
+		if band.MinPercent < 0 || band.MinPercent > 100 || percents[band.MinPercent] {
+			return fmt.Errorf("invalid or repeated occupancy band %v%%", band.MinPercent)
+		}
+		if band.Multiplier <= 0 {
+			return fmt.Errorf("occupancy band %v%%: multiplier must be positive", band.MinPercent)
+		}
+		percents[band.MinPercent] = true
+	}
+
+	windows := make(map[time.Duration]bool)
+	for _, band := range r.BookingWindow {
+		if band.Within <= 0 || windows[band.Within] {
+			return fmt.Errorf("invalid or repeated booking window band %v", band.Within)
+		}
+		if band.Multiplier <= 0 {
+			return fmt.Errorf("booking window band %v: multiplier must be positive", band.Within)
+		}
+		windows[band.Within] = true
+	}
+
+	for day, multiplier := range r.DayOfWeek {
+		if multiplier <= 0 {
+			return fmt.Errorf("%s: multiplier must be positive", day)
+		}
+	}
+	return nil
+}
+
+// PricingConditions describe the sale a fare is priced for.
+type PricingConditions struct {
+	// Occupied and Capacity count the seats of the fare class taken on the
+	// journey and the seats the class has.
+	Occupied int
+	Capacity int
+	// DepartureTime is zero for a departure without a timetable, in which
+	// case the booking window and day-of-week rules do not apply.
+	DepartureTime time.Time
+	Now           time.Time
+}
+
+// PriceAdjustment is one rule applied to a fare.
+type PriceAdjustment struct {
+	Rule       string
+	Multiplier float64
+	// Amount is the change in price in minor units, after rounding.
+	Amount int64
+}
+
+// PricingEngine prices fares by occupancy, time to departure and day of week.
+// An engine without rules leaves every fare unchanged.
+type PricingEngine struct {
+	mu    sync.RWMutex
+	rules PricingRules
+}
+
+// NewPricingEngine creates an engine applying the given rules.
+func NewPricingEngine(rules PricingRules) (*PricingEngine, error) {
+	engine := &PricingEngine{}
+	if err := engine.SetRules(rules); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// SetRules replaces the rules used for later prices.
+func (e *PricingEngine) SetRules(rules PricingRules) error {
+	if err := rules.validate(); err != nil {
+		return err
+	}
+
+	occupancy := append([]OccupancyBand(nil), rules.Occupancy...)
+	sort.Slice(occupancy, func(i, j int) bool { return occupancy[i].MinPercent > occupancy[j].MinPercent })
+	window := append([]BookingWindowBand(nil), rules.BookingWindow...)
+	sort.Slice(window, func(i, j int) bool { return window[i].Within < window[j].Within })
+	days := make(map[time.Weekday]float64, len(rules.DayOfWeek))
+	for day, multiplier := range rules.DayOfWeek {
+		days[day] = multiplier
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.rules = PricingRules{Occupancy: occupancy, BookingWindow: window, DayOfWeek: days}
+	return nil
+}
+
+// Rules returns the rules in use, with occupancy bands from the highest and
+// booking window bands from the shortest.
+func (e *PricingEngine) Rules() PricingRules {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.rules
+}
+
+// Price applies the matching rules to a fare in minor units in the order
+// occupancy, booking window, day of week. Each adjustment's amount is rounded
+// to a minor unit, so the fare plus the amounts is exactly the returned price.
+func (e *PricingEngine) Price(fare int64, conditions PricingConditions) (int64, []PriceAdjustment) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	price := fare
+	adjustments := []PriceAdjustment{}
+	apply := func(rule string, multiplier float64) {
+		if multiplier == 1 {
+			return
+		}
+		amount := scaleMinorUnits(price, ratio(multiplier)) - price
+		price += amount
+		adjustments = append(adjustments, PriceAdjustment{Rule: rule, Multiplier: multiplier, Amount: amount})
+	}
+
+	if conditions.Capacity > 0 {
+		percent := float64(conditions.Occupied) * 100 / float64(conditions.Capacity)
+		for _, band := range e.rules.Occupancy {
+			if percent >= band.MinPercent {
+				apply(fmt.Sprintf("occupancy >= %v%%", band.MinPercent), band.Multiplier)
+				break
+			}
+		}
+	}
+
+	if !conditions.DepartureTime.IsZero() {
+		untilDeparture := conditions.DepartureTime.Sub(conditions.Now)
+		for _, band := range e.rules.BookingWindow {
+			if untilDeparture >= 0 && untilDeparture <= band.Within {
+				apply(fmt.Sprintf("departs within %v", band.Within), band.Multiplier)
+				break
+			}
+		}
+
+		day := conditions.DepartureTime.Weekday()
+		if multiplier, ok := e.rules.DayOfWeek[day]; ok {
+			apply(fmt.Sprintf("departs on %s", day), multiplier)
+		}
+	}
+
+	return price, adjustments
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPricingEnginePrice(t *testing.T) {
+	engine, err := NewPricingEngine(PricingRules{
+		Occupancy: []OccupancyBand{
+			{MinPercent: 50, Multiplier: 1.2},
+			{MinPercent: 90, Multiplier: 1.5},
+		},
+		BookingWindow: []BookingWindowBand{
+			{Within: 7 * 24 * time.Hour, Multiplier: 1.1},
+			{Within: 24 * time.Hour, Multiplier: 1.25},
+		},
+		DayOfWeek: map[time.Weekday]float64{time.Friday: 1.1, time.Tuesday: 0.9},
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC) // a Monday
+	tests := []struct {
+		name          string
+		conditions    PricingConditions
+		expectedPrice int64
+		expectedRules []string
+	}{
+		{
+			name:          "No rule applies",
+			conditions:    PricingConditions{Occupied: 1, Capacity: 10, DepartureTime: now.Add(30 * 24 * time.Hour), Now: now},
+			expectedPrice: 2000,
+			expectedRules: []string{},
+		},
+		{
+			name:          "Highest occupancy band reached",
+			conditions:    PricingConditions{Occupied: 9, Capacity: 10, Now: now},
+			expectedPrice: 3000,
+			expectedRules: []string{"occupancy >= 90%"},
+		},
+		{
+			name:          "Shortest booking window",
+			conditions:    PricingConditions{Capacity: 10, DepartureTime: now.Add(2 * time.Hour), Now: now},
+			expectedPrice: 2500,
+			expectedRules: []string{"departs within 24h0m0s"},
+		},
+		{
+			name:          "Day of week discount",
+			conditions:    PricingConditions{Capacity: 10, DepartureTime: now.Add(8 * 24 * time.Hour), Now: now},
+			expectedPrice: 1800,
+			expectedRules: []string{"departs on Tuesday"},
+		},
+		{
+			name:          "Rules compound in order",
+			conditions:    PricingConditions{Occupied: 5, Capacity: 10, DepartureTime: now.Add(4 * 24 * time.Hour), Now: now},
+			expectedPrice: 2904,
+			expectedRules: []string{"occupancy >= 50%", "departs within 168h0m0s", "departs on Friday"},
+		},
+		{
+			name:          "Departure without a time ignores time rules",
+			conditions:    PricingConditions{Capacity: 10, Now: now},
+			expectedPrice: 2000,
+			expectedRules: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price, adjustments := engine.Price(2000, tt.conditions)
+			assert.Equal(t, tt.expectedPrice, price)
+
+			rules := []string{}
+			total := int64(2000)
+			for _, adjustment := range adjustments {
+				rules = append(rules, adjustment.Rule)
+				total += adjustment.Amount
+			}
+			assert.Equal(t, tt.expectedRules, rules)
+			assert.Equal(t, price, total, "Adjustments should reconcile with the price")
+		})
+	}
+}
+
+func TestPricingRulesValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		rules     PricingRules
+		expectErr bool
+	}{
+		{name: "No rules", rules: PricingRules{}},
+		{name: "Occupancy over 100%", rules: PricingRules{Occupancy: []OccupancyBand{{MinPercent: 120, Multiplier: 2}}}, expectErr: true},
+		{name: "Repeated occupancy band", rules: PricingRules{Occupancy: []OccupancyBand{{MinPercent: 50, Multiplier: 1.2}, {MinPercent: 50, Multiplier: 1.3}}}, expectErr: true},
+		{name: "Zero multiplier", rules: PricingRules{Occupancy: []OccupancyBand{{MinPercent: 50}}}, expectErr: true},
+		{name: "Empty booking window", rules: PricingRules{BookingWindow: []BookingWindowBand{{Multiplier: 1.2}}}, expectErr: true},
+		{name: "Negative day multiplier", rules: PricingRules{DayOfWeek: map[time.Weekday]float64{time.Sunday: -1}}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewPricingEngine(tt.rules)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// PromoCode discounts a booking by Percent of its price or by a fixed Amount
+// in minor units of the pricing currency. Uses are counted from the bookings
+// that hold the code, so cancelling a booking gives its use back.
+type PromoCode struct {
+	Code    string
+	Percent float64
+	Amount  int64
+	// ValidFrom and ValidUntil bound when the code can be used; a zero time
+	// leaves that end open.
+	ValidFrom  time.Time
+	ValidUntil time.Time
+	// Routes lists the "From-To" journeys the code is valid for; empty
+	// allows every journey.
+	Routes []string
+	// MaxUses and MaxUsesPerEmail cap the bookings using the code overall
+	// and per booking email; zero is unlimited.
+	MaxUses         int
+	MaxUsesPerEmail int
+	// Stackable codes can be combined with other stackable codes. A code
+	// that is not stackable must be used on its own.
+	Stackable bool
+	Disabled  bool
+}
+
+// normalizePromoCode returns the stored form of a code.
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// validate checks that the code describes a usable discount.
+func (c PromoCode) validate() error {
+	if c.Code == "" {
+		return fmt.Errorf("promo code is required")
+	}
+	if (c.Percent == 0) == (c.Amount == 0) {
+		return fmt.Errorf("promo code %s: exactly one of a percentage and an amount is required", c.Code)
+	}
+	if c.Percent < 0 || c.Percent > 100 || c.Amount < 0 {
+		return fmt.Errorf("promo code %s: invalid discount", c.Code)
+	}
+	if !c.ValidFrom.IsZero() && !c.ValidUntil.IsZero() && !c.ValidUntil.After(c.ValidFrom) {
+		return fmt.Errorf("promo code %s: validity ends before it starts", c.Code)
+	}
+	for _, route := range c.Routes {
+		if from, to, ok := strings.Cut(route, "-"); !ok || from == "" || to == "" {
+			return fmt.Errorf("promo code %s: invalid route %q", c.Code, route)
+		}
+	}
+	if c.MaxUses < 0 || c.MaxUsesPerEmail < 0 {
+		return fmt.Errorf("promo code %s: usage caps must not be negative", c.Code)
+	}
+	return nil
+}
+
+// AddPromoCode makes a promo code available for bookings and saves it.
+func (t *TicketManager) AddPromoCode(code PromoCode) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.addPromoCode(code)
+}
+
+// addPromoCode stores a promo code under its normalized form. Callers must
+// hold t.mu.
+func (t *TicketManager) addPromoCode(code PromoCode) error {
+	code.Code = normalizePromoCode(code.Code)
+	code.Routes = append([]string(nil), code.Routes...)
+	if err := code.validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := t.promoCodes[code.Code]; ok {
+		return status.Errorf(codes.AlreadyExists, "promo code %s already exists", code.Code)
+	}
+	if err := t.commitPromoCode(&code); err != nil {
+		return err
+	}
+
+	t.promoCodes[code.Code] = &code
+	return nil
+}
+
+// commitPromoCode saves a promo code to the store. Callers must hold t.mu.
+func (t *TicketManager) commitPromoCode(code *PromoCode) error {
+	if err := t.store.Commit(&Mutation{PromoCodes: map[string]*pb.PromoCode{code.Code: code.toProto(t.Currency)}}); err != nil {
+		log.Printf("Promo code %s persist failed: %v", code.Code, err)
+		return status.Error(codes.Internal, "failed to save promo code")
+	}
+	return nil
+}
+
+// restorePromoCodes replaces the promo codes with those saved in the store.
+// Callers must hold t.mu.
+func (t *TicketManager) restorePromoCodes(saved map[string]*pb.PromoCode) {
+	t.promoCodes = make(map[string]*PromoCode, len(saved))
+	for key, message := range saved {
+		code := promoCodeFromProto(message)
+		t.promoCodes[key] = &code
+	}
+}
+
+// promoCodeUses counts the bookings using a promo code, overall and for an email,
+// including bookings being paid for. Callers must hold t.mu.
+func (t *TicketManager) promoCodeUses(code, email string) (int, int) {
+	total, byEmail := 0, 0
+	for _, receipts := range []map[string]*pb.TicketReceipt{t.Receipts, t.pending} {
+		for _, receipt := range receipts {
+			for _, discount := range receipt.Discounts {
+				if discount.Code != code {
+					continue
+				}
+				total++
+				if receipt.GetUser().GetEmail() == email {
+					byEmail++
+				}
+			}
+		}
+	}
+	return total, byEmail
+}
+
+// validPromoCodes looks up the promo codes a booking asks for at now and
+// checks that each can be used on it and that they can be combined. Callers
+// must hold t.mu.
+func (t *TicketManager) validPromoCodes(b booking, now time.Time) ([]*PromoCode, error) {
+	promoCodes := []*PromoCode{}
+	seen := make(map[string]bool)
+	for _, name := range b.promoCodes {
+		name = normalizePromoCode(name)
+		if seen[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "promo code %s given twice", name)
+		}
+		seen[name] = true
+
+		code, ok := t.promoCodes[name]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "promo code %s not found", name)
+		}
+		if code.Disabled {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s is disabled", name)
+		}
+		if (!code.ValidFrom.IsZero() && now.Before(code.ValidFrom)) || (!code.ValidUntil.IsZero() && !now.Before(code.ValidUntil)) {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s is not valid now", name)
+		}
+		if len(code.Routes) > 0 && !containsString(code.Routes, b.from+"-"+b.to) {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s is not valid for this journey", name)
+		}
+
+		total, byEmail := t.promoCodeUses(name, b.passengers[0].GetEmail())
+		if code.MaxUses > 0 && total >= code.MaxUses {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s has been used up", name)
+		}
+		if code.MaxUsesPerEmail > 0 && byEmail >= code.MaxUsesPerEmail {
+			return nil, status.Errorf(codes.FailedPrecondition, "promo code %s has been used up for this email", name)
+		}
+		promoCodes = append(promoCodes, code)
+	}
+
+	if len(promoCodes) > 1 {
+		for _, code := range promoCodes {
+			if !code.Stackable {
+				return nil, status.Errorf(codes.InvalidArgument, "promo code %s cannot be combined with other codes", code.Code)
+			}
+		}
+	}
+	return promoCodes, nil
+}
+
+// applyPromoCodes discounts a price in minor units by each promo code in
+// turn. A percentage is taken of the price left by the codes before it, and no
+// code takes the price below zero. It returns the total discount and each
+// code's share.
+func applyPromoCodes(promoCodes []*PromoCode, price *pb.Money) (*pb.Money, []*pb.AppliedDiscount) {
+	total := int64(0)
+	discounts := []*pb.AppliedDiscount{}
+	for _, code := range promoCodes {
+		remaining := price.GetMinorUnits() - total
+		amount := code.Amount
+		if code.Percent > 0 {
+			amount = scaleMinorUnits(remaining, new(big.Rat).Quo(ratio(code.Percent), big.NewRat(100, 1)))
+		}
+		if amount > remaining {
+			amount = remaining
+		}
+		total += amount
+		discounts = append(discounts, &pb.AppliedDiscount{Code: code.Code, Amount: newMoney(price.GetCurrencyCode(), amount)})
+	}
+	return newMoney(price.GetCurrencyCode(), total), discounts
+}
+
+// receiptPromoCodes returns the promo codes a receipt was discounted by, in
+// the order they were applied, whether or not they can still be used. A code
+// no longer known is taken as the amount it took off. Callers must hold t.mu.
+func (t *TicketManager) receiptPromoCodes(receipt *pb.TicketReceipt) []*PromoCode {
+	promoCodes := []*PromoCode{}
+	for _, discount := range receipt.Discounts {
+		code, ok := t.promoCodes[discount.Code]
+		if !ok {
+			code = &PromoCode{Code: discount.Code, Amount: discount.Amount.GetMinorUnits()}
+		}
+		promoCodes = append(promoCodes, code)
+	}
+	return promoCodes
+}
+
+// containsString reports whether values contains value.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// CreatePromoCode adds a promo code.
+func (a *AdminServer) CreatePromoCode(ctx context.Context, req *pb.PromoCode) (*pb.PromoCode, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("CreatePromoCode request received: %+v", req)
+
+	if req.AmountOff != nil && req.AmountOff.CurrencyCode != t.Currency {
+		log.Printf("CreatePromoCode amount in another currency: %s", req.AmountOff.CurrencyCode)
+		return nil, status.Errorf(codes.InvalidArgument, "amount off must be in %s", t.Currency)
+	}
+
+	if err := t.addPromoCode(promoCodeFromProto(req)); err != nil {
+		log.Printf("CreatePromoCode failed: %v", err)
+		return nil, err
+	}
+
+	response := t.promoCodeToProto(t.promoCodes[normalizePromoCode(req.Code)])
+	log.Printf("CreatePromoCode successful: %+v", response)
+	return response, nil
+}
+
+// ListPromoCodes lists the promo codes ordered by code, leaving out disabled
+// codes unless asked for.
+func (a *AdminServer) ListPromoCodes(ctx context.Context, req *pb.ListPromoCodesRequest) (*pb.ListPromoCodesResponse, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ListPromoCodes request received: %+v", req)
+
+	names := make([]string, 0, len(t.promoCodes))
+	for name, code := range t.promoCodes {
+		if !code.Disabled || req.IncludeDisabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	response := &pb.ListPromoCodesResponse{}
+	for _, name := range names {
+		response.PromoCodes = append(response.PromoCodes, t.promoCodeToProto(t.promoCodes[name]))
+	}
+
+	log.Printf("ListPromoCodes successful: %d codes", len(response.PromoCodes))
+	return response, nil
+}
+
+// DisablePromoCode stops a promo code from being used on new bookings.
+// Bookings already made with it keep their discount.
+func (a *AdminServer) DisablePromoCode(ctx context.Context, req *pb.DisablePromoCodeRequest) (*pb.PromoCode, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("DisablePromoCode request received: %+v", req)
+
+	code, ok := t.promoCodes[normalizePromoCode(req.Code)]
+	if !ok {
+		log.Printf("DisablePromoCode code not found: %s", req.Code)
+		return nil, status.Error(codes.NotFound, "promo code not found")
+	}
+	disabled := *code
+	disabled.Disabled = true
+	if err := t.commitPromoCode(&disabled); err != nil {
+		return nil, err
+	}
+	code.Disabled = true
+
+	response := t.promoCodeToProto(code)
+	log.Printf("DisablePromoCode successful: %+v", response)
+	return response, nil
+}
+
+// promoCodeToProto converts a promo code to its API representation,
+// including its uses. Callers must hold t.mu.
+func (t *TicketManager) promoCodeToProto(code *PromoCode) *pb.PromoCode {
+	uses, _ := t.promoCodeUses(code.Code, "")
+	response := code.toProto(t.Currency)
+	response.Uses = int32(uses)
+	return response
+}
+
+// toProto converts a promo code to the message it is saved as, with amounts
+// in currency.
+func (c *PromoCode) toProto(currency string) *pb.PromoCode {
+	message := &pb.PromoCode{
+		Code:            c.Code,
+		PercentOff:      c.Percent,
+		AmountOff:       newMoney(currency, c.Amount),
+		Routes:          append([]string(nil), c.Routes...),
+		MaxUses:         int32(c.MaxUses),
+		MaxUsesPerEmail: int32(c.MaxUsesPerEmail),
+		Stackable:       c.Stackable,
+		Disabled:        c.Disabled,
+	}
+	if !c.ValidFrom.IsZero() {
+		message.ValidFrom = timestamppb.New(c.ValidFrom)
+	}
+	if !c.ValidUntil.IsZero() {
+		message.ValidUntil = timestamppb.New(c.ValidUntil)
+	}
+	return message
+}
+
+// promoCodeFromProto converts an API or saved promo code message to a promo
+// code.
+func promoCodeFromProto(message *pb.PromoCode) PromoCode {
+	code := PromoCode{
+		Code:            message.Code,
+		Percent:         message.PercentOff,
+		Amount:          message.AmountOff.GetMinorUnits(),
+		Routes:          message.Routes,
+		MaxUses:         int(message.MaxUses),
+		MaxUsesPerEmail: int(message.MaxUsesPerEmail),
+		Stackable:       message.Stackable,
+		Disabled:        message.Disabled,
+	}
+	if message.ValidFrom != nil {
+		code.ValidFrom = message.ValidFrom.AsTime()
+	}
+	if message.ValidUntil != nil {
+		code.ValidUntil = message.ValidUntil.AsTime()
+	}
+	return code
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// failingStore loads nothing and fails every commit.
+type failingStore struct{}
+
+func (failingStore) Load() (*BookingState, error) {
+	return &BookingState{}, nil
+}
+
+func (failingStore) Commit(*Mutation) error {
+	return errors.New("disk full")
+}
+
+// createPromoTicketManager returns a test manager, where London-France costs
+// 2000 pence, with a range of promo codes.
+func createPromoTicketManager(t *testing.T) *TicketManager {
+	tm := createTestTicketManager()
+	now := time.Now()
+	for _, code := range []PromoCode{
+		{Code: "QUARTER", Percent: 25},
+		{Code: "FIVER", Amount: 500, Stackable: true},
+		{Code: "TENPC", Percent: 10, Stackable: true},
+		{Code: "HUGE", Amount: 10000},
+		{Code: "OLD", Percent: 10, ValidUntil: now.Add(-time.Hour)},
+		{Code: "SOON", Percent: 10, ValidFrom: now.Add(time.Hour)},
+		{Code: "PARIS", Percent: 10, Routes: []string{"London-Paris"}},
+		{Code: "FRANCE", Percent: 10, Routes: []string{"London-France"}},
+		{Code: "OFF", Percent: 10, Disabled: true},
+		{Code: "ONCE", Percent: 10, MaxUses: 1},
+		{Code: "MINE", Percent: 10, MaxUsesPerEmail: 1},
+	} {
+		require.NoError(t, tm.AddPromoCode(code))
+	}
+	return tm
+}
+
+func TestApplyPromoCodes(t *testing.T) {
+	tests := []struct {
+		name              string
+		promoCodes        []*PromoCode
+		expectedDiscount  int64
+		expectedDiscounts []int64
+	}{
+		{name: "No codes", expectedDiscount: 0, expectedDiscounts: []int64{}},
+		{name: "Percentage", promoCodes: []*PromoCode{{Code: "A", Percent: 25}}, expectedDiscount: 1000, expectedDiscounts: []int64{1000}},
+		{name: "Fixed amount", promoCodes: []*PromoCode{{Code: "A", Amount: 750}}, expectedDiscount: 750, expectedDiscounts: []int64{750}},
+		{
+			name:              "Percentage of what is left",
+			promoCodes:        []*PromoCode{{Code: "A", Amount: 1000}, {Code: "B", Percent: 10}},
+			expectedDiscount:  1300,
+			expectedDiscounts: []int64{1000, 300},
+		},
+		{
+			name:              "Never below zero",
+			promoCodes:        []*PromoCode{{Code: "A", Amount: 3000}, {Code: "B", Amount: 3000}},
+			expectedDiscount:  4000,
+			expectedDiscounts: []int64{3000, 1000},
+		},
+		{name: "Rounded to minor units", promoCodes: []*PromoCode{{Code: "A", Percent: 33.333}}, expectedDiscount: 1333, expectedDiscounts: []int64{1333}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, discounts := applyPromoCodes(tt.promoCodes, newMoney("GBP", 4000))
+			assert.Equal(t, tt.expectedDiscount, discount.GetMinorUnits())
+			assert.Equal(t, "GBP", discount.CurrencyCode)
+
+			amounts := []int64{}
+			for _, applied := range discounts {
+				amounts = append(amounts, applied.Amount.GetMinorUnits())
+			}
+			assert.Equal(t, tt.expectedDiscounts, amounts)
+		})
+	}
+}
+
+func TestPurchaseTicketPromoCodes(t *testing.T) {
+	tests := []struct {
+		name             string
+		promoCodes       []string
+		expectedPrice    int64
+		expectedDiscount int64
+		expectedCode     codes.Code
+	}{
+		{name: "Percentage", promoCodes: []string{"QUARTER"}, expectedPrice: 1500, expectedDiscount: 500},
+		{name: "Codes are case insensitive", promoCodes: []string{"quarter"}, expectedPrice: 1500, expectedDiscount: 500},
+		{name: "Stacked in order", promoCodes: []string{"FIVER", "TENPC"}, expectedPrice: 1350, expectedDiscount: 650},
+		{name: "Free but not negative", promoCodes: []string{"HUGE"}, expectedPrice: 0, expectedDiscount: 2000},
+		{name: "Route restriction met", promoCodes: []string{"FRANCE"}, expectedPrice: 1800, expectedDiscount: 200},
+		{name: "Unknown code", promoCodes: []string{"NOPE"}, expectedCode: codes.NotFound},
+		{name: "Disabled code", promoCodes: []string{"OFF"}, expectedCode: codes.FailedPrecondition},
+		{name: "Expired code", promoCodes: []string{"OLD"}, expectedCode: codes.FailedPrecondition},
+		{name: "Code not valid yet", promoCodes: []string{"SOON"}, expectedCode: codes.FailedPrecondition},
+		{name: "Other route", promoCodes: []string{"PARIS"}, expectedCode: codes.FailedPrecondition},
+		{name: "Code given twice", promoCodes: []string{"FIVER", "fiver"}, expectedCode: codes.InvalidArgument},
+		{name: "Code that does not stack", promoCodes: []string{"QUARTER", "FIVER"}, expectedCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := createPromoTicketManager(t)
+
+			receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+				User:       &pb.User{FirstName: "Ada", Email: "ada@example.com"},
+				From:       "London",
+				To:         "France",
+				PromoCodes: tt.promoCodes,
+			})
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.Empty(t, tm.Receipts)
+				assert.Equal(t, "Available", tm.SeatManager.Sections["A"].SeatState(1), "No seat should be taken")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(2000), receipt.OriginalPrice.GetMinorUnits())
+			assert.Equal(t, tt.expectedDiscount, receipt.Discount.GetMinorUnits())
+			assert.Equal(t, tt.expectedPrice, receipt.Price.GetMinorUnits())
+			assert.Len(t, receipt.Discounts, len(tt.promoCodes))
+		})
+	}
+}
+
+func TestPromoCodeUsageCaps(t *testing.T) {
+	tm := createPromoTicketManager(t)
+	purchase := func(email, code string) (*pb.TicketReceipt, error) {
+		return tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{FirstName: "Ada", Email: email}, From: "London", To: "France", PromoCodes: []string{code},
+		})
+	}
+
+	receipt, err := purchase("ada@example.com", "ONCE")
+	require.NoError(t, err)
+	_, err = purchase("charles@example.com", "ONCE")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Global cap should be reached")
+
+	// Cancelling the booking gives the use back.
+	_, err = tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	_, err = purchase("charles@example.com", "ONCE")
+	assert.NoError(t, err)
+
+	_, err = purchase("ada@example.com", "MINE")
+	require.NoError(t, err)
+	_, err = purchase("ada@example.com", "MINE")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Per-email cap should be reached")
+	_, err = purchase("charles@example.com", "MINE")
+	assert.NoError(t, err)
+}
+
+func TestConfirmHoldWithPromoCode(t *testing.T) {
+	tm := createPromoTicketManager(t)
+
+	hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "France"})
+	require.NoError(t, err)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Ada", Email: "ada@example.com"}, HoldId: hold.HoldId, PromoCodes: []string{"QUARTER"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500), receipt.Price.GetMinorUnits())
+}
+
+func TestPromoCodeAdmin(t *testing.T) {
+	tm := createTestTicketManager()
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+
+	created, err := admin.CreatePromoCode(ctx, &pb.PromoCode{
+		Code: "spring", PercentOff: 15, MaxUses: 10,
+		ValidUntil: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SPRING", created.Code)
+	assert.NotNil(t, created.ValidUntil)
+	assert.Nil(t, created.ValidFrom)
+
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "Spring", AmountOff: newMoney("GBP", 500)})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "BOTH", PercentOff: 10, AmountOff: newMoney("GBP", 500)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "EURO", AmountOff: newMoney("EUR", 500)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "ROUTE", PercentOff: 10, Routes: []string{"London"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	autumn, err := admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "AUTUMN", AmountOff: newMoney("GBP", 500)})
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), autumn.AmountOff.GetMinorUnits())
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "France", PromoCodes: []string{"SPRING"},
+	})
+	require.NoError(t, err)
+
+	listed, err := admin.ListPromoCodes(ctx, &pb.ListPromoCodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listed.PromoCodes, 2)
+	assert.Equal(t, "AUTUMN", listed.PromoCodes[0].Code)
+	assert.Equal(t, "SPRING", listed.PromoCodes[1].Code)
+	assert.Equal(t, int32(1), listed.PromoCodes[1].Uses)
+
+	disabled, err := admin.DisablePromoCode(ctx, &pb.DisablePromoCodeRequest{Code: "autumn"})
+	require.NoError(t, err)
+	assert.True(t, disabled.Disabled)
+	_, err = admin.DisablePromoCode(ctx, &pb.DisablePromoCodeRequest{Code: "NOPE"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	listed, err = admin.ListPromoCodes(ctx, &pb.ListPromoCodesRequest{})
+	require.NoError(t, err)
+	assert.Len(t, listed.PromoCodes, 1)
+	listed, err = admin.ListPromoCodes(ctx, &pb.ListPromoCodesRequest{IncludeDisabled: true})
+	require.NoError(t, err)
+	assert.Len(t, listed.PromoCodes, 2)
+}
+
+func TestPromoCodesSurviveRestart(t *testing.T) {
+	store, err := NewWALStore(t.TempDir(), 100)
+	require.NoError(t, err)
+	defer store.Close()
+	sections := []SectionConfigs{{SectionName: "A", MaxSeats: 4}}
+	tm := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "SPRING", PercentOff: 15, MaxUses: 1})
+	require.NoError(t, err)
+	_, err = admin.CreatePromoCode(ctx, &pb.PromoCode{Code: "AUTUMN", AmountOff: newMoney("GBP", 500)})
+	require.NoError(t, err)
+	_, err = admin.DisablePromoCode(ctx, &pb.DisablePromoCodeRequest{Code: "AUTUMN"})
+	require.NoError(t, err)
+	_, err = tm.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "ada@example.com"}, From: "London", To: "Paris", PromoCodes: []string{"SPRING"},
+	})
+	require.NoError(t, err)
+
+	restarted := NewTicketManager(NewSeatManager(sections, store), map[string]int64{"London-Paris": 2000}, store)
+	require.NoError(t, restarted.Restore())
+	listed, err := NewAdminServer(restarted).ListPromoCodes(ctx, &pb.ListPromoCodesRequest{IncludeDisabled: true})
+	require.NoError(t, err)
+	require.Len(t, listed.PromoCodes, 2)
+	assert.True(t, listed.PromoCodes[0].Disabled, "AUTUMN stays disabled")
+	assert.Equal(t, int32(1), listed.PromoCodes[1].Uses)
+	_, err = restarted.PurchaseTicket(ctx, &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "bo@example.com"}, From: "London", To: "Paris", PromoCodes: []string{"SPRING"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Uses are still counted after a restart")
+}
+
+func TestPromoCodeNotSaved(t *testing.T) {
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 4}}, NewMemoryStore()), map[string]int64{"London-Paris": 2000}, failingStore{})
+
+	_, err := NewAdminServer(tm).CreatePromoCode(context.Background(), &pb.PromoCode{Code: "SPRING", PercentOff: 15})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Empty(t, tm.promoCodes)
+}
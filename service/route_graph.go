@@ -0,0 +1,315 @@
+package service
+
+import (
+	"container/heap"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultMaxLegs is the longest journey, in legs, that FindJourney returns.
+const DefaultMaxLegs = 3
+
+// RouteLeg is a direct connection between two stations. Its fare is Fare when
+// set, otherwise it is derived from Distance, in km, using the graph's tariff.
+// Fares are in minor units of the pricing currency.
+type RouteLeg struct {
+	From     string
+	To       string
+	Fare     int64
+	Distance float64
+}
+
+// Tariff prices legs that have a distance but no fixed fare, in minor units
+// of the pricing currency.
+type Tariff struct {
+	BaseFare int64
+	PerKm    int64
+}
+
+// JourneyLeg is a leg of a journey together with its computed fare in minor
+// units.
+type JourneyLeg struct {
+	From string
+	To   string
+	Fare int64
+}
+
+// RouteGraph is a directed graph of stations connected by legs. It prices
+// journeys as the sum of their leg fares and finds indirect journeys when two
+// stations are not directly connected.
+//
+// Leg fares are standard-class fares. A fare class can have its own fare
+// table; legs missing from it are charged the standard fare.
+type RouteGraph struct {
+	mu         sync.RWMutex
+	legs       map[string]map[string]RouteLeg
+	classFares map[string]map[string]map[string]int64
+	Tariff     Tariff
+	MaxLegs    int
+}
+
+// NewRouteGraph builds a graph from "From-To" station connections and their
+// fares in minor units. Connections without a separator or with a zero fare
+// are skipped.
+func NewRouteGraph(stationConnection map[string]int64) *RouteGraph {
+	graph := &RouteGraph{
+		legs:       make(map[string]map[string]RouteLeg),
+		classFares: make(map[string]map[string]map[string]int64),
+		MaxLegs:    DefaultMaxLegs,
+	}
+
+	for connection, fare := range stationConnection {
+		from, to, ok := strings.Cut(connection, "-")
+		if !ok {
+			log.Printf("NewRouteGraph skipping invalid station connection %q", connection)
+			continue
+		}
+		if err := graph.AddLeg(RouteLeg{From: from, To: to, Fare: fare}); err != nil {
+			log.Printf("NewRouteGraph skipping station connection: %v", err)
+		}
+	}
+
+	return graph
+}
+
+// AddLeg adds or replaces the direct leg between two stations.
+func (g *RouteGraph) AddLeg(leg RouteLeg) error {
+	if leg.From == "" || leg.To == "" || leg.From == leg.To {
+		return fmt.Errorf("invalid leg %s-%s", leg.From, leg.To)
+	}
+	if leg.Fare < 0 || leg.Distance < 0 {
+		return fmt.Errorf("leg %s-%s: fare and distance must not be negative", leg.From, leg.To)
+	}
+	if leg.Fare == 0 && leg.Distance == 0 {
+		return fmt.Errorf("leg %s-%s: a fare or a distance is required", leg.From, leg.To)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.legs[leg.From] == nil {
+		g.legs[leg.From] = make(map[string]RouteLeg)
+	}
+	g.legs[leg.From][leg.To] = leg
+	return nil
+}
+
+// Legs returns every leg in the graph ordered by origin and destination.
+func (g *RouteGraph) Legs() []RouteLeg {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	legs := []RouteLeg{}
+	for _, destinations := range g.legs {
+		for _, leg := range destinations {
+			legs = append(legs, leg)
+		}
+	}
+	sort.Slice(legs, func(i, j int) bool {
+		if legs[i].From != legs[j].From {
+			return legs[i].From < legs[j].From
+		}
+		return legs[i].To < legs[j].To
+	})
+	return legs
+}
+
+// legFare prices a single leg, rounding the distance charge to a minor unit.
+// Callers must hold g.mu.
+func (g *RouteGraph) legFare(leg RouteLeg) int64 {
+	if leg.Fare > 0 {
+		return leg.Fare
+	}
+	return g.Tariff.BaseFare + scaleMinorUnits(g.Tariff.PerKm, ratio(leg.Distance))
+}
+
+// AddFareTable sets the fares of a fare class from "From-To" station
+// connections and their fares in minor units, replacing any earlier table for
+// the class. Every connection must be a leg of the graph with a positive fare.
+func (g *RouteGraph) AddFareTable(class string, stationConnection map[string]int64) error {
+	if class == "" {
+		return fmt.Errorf("fare class is required")
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	table := make(map[string]map[string]int64)
+	for connection, fare := range stationConnection {
+		from, to, ok := strings.Cut(connection, "-")
+		if !ok {
+			return fmt.Errorf("fare class %s: invalid station connection %q", class, connection)
+		}
+		if _, ok := g.legs[from][to]; !ok {
+			return fmt.Errorf("fare class %s: no leg %s-%s", class, from, to)
+		}
+		if fare <= 0 {
+			return fmt.Errorf("fare class %s: leg %s-%s: fare must be positive", class, from, to)
+		}
+		if table[from] == nil {
+			table[from] = make(map[string]int64)
+		}
+		table[from][to] = fare
+	}
+
+	g.classFares[class] = table
+	return nil
+}
+
+// newFareGraph builds a graph from "From-To" station connections and fare
+// tables keyed by class, as taken by NewRouteGraph and AddFareTable, failing
+// on the first invalid connection instead of skipping it.
+func newFareGraph(stationConnection map[string]int64, classFares map[string]map[string]int64) (*RouteGraph, error) {
+	graph := NewRouteGraph(nil)
+	for connection, fare := range stationConnection {
+		from, to, ok := strings.Cut(connection, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid station connection %q", connection)
+		}
+		if err := graph.AddLeg(RouteLeg{From: from, To: to, Fare: fare}); err != nil {
+			return nil, err
+		}
+	}
+	for class, fares := range classFares {
+		if err := graph.AddFareTable(class, fares); err != nil {
+			return nil, err
+		}
+	}
+	return graph, nil
+}
+
+// hasLeg reports whether the graph has a direct leg between two stations.
+func (g *RouteGraph) hasLeg(from, to string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	_, ok := g.legs[from][to]
+	return ok
+}
+
+// replaceFares replaces every leg and class fare table of the graph with
+// those of next in one step. The tariff and journey length limit are kept.
+func (g *RouteGraph) replaceFares(next *RouteGraph) {
+	next.mu.RLock()
+	legs, classFares := next.legs, next.classFares
+	next.mu.RUnlock()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.legs = legs
+	g.classFares = classFares
+}
+
+// ClassFare prices a journey leg in a fare class: the class fare table's fare
+// when it has one for the leg, otherwise the leg's standard fare.
+func (g *RouteGraph) ClassFare(class string, leg JourneyLeg) int64 {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	if fare, ok := g.classFares[class][leg.From][leg.To]; ok {
+		return fare
+	}
+	return leg.Fare
+}
+
+// LegFare prices the direct leg between two stations in a fare class.
+func (g *RouteGraph) LegFare(class, from, to string) (int64, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	leg, ok := g.legs[from][to]
+	if !ok {
+		return 0, fmt.Errorf("no leg %s-%s", from, to)
+	}
+	if fare, ok := g.classFares[class][from][to]; ok {
+		return fare, nil
+	}
+	return g.legFare(leg), nil
+}
+
+// hasFareClass reports whether class is sold on the graph: standard, or a
+// class with a fare table.
+func (g *RouteGraph) hasFareClass(class string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	_, ok := g.classFares[class]
+	return fareClassOrDefault(class) == FareClassStandard || ok
+}
+
+// journeyStep is a search state in FindJourney.
+type journeyStep struct {
+	station string
+	fare    int64
+	legs    []JourneyLeg
+}
+
+// journeyQueue orders search states by fare, then by number of legs, then by
+// station name so results are deterministic.
+type journeyQueue []*journeyStep
+
+func (q journeyQueue) Len() int { return len(q) }
+func (q journeyQueue) Less(i, j int) bool {
+	if q[i].fare != q[j].fare {
+		return q[i].fare < q[j].fare
+	}
+	if len(q[i].legs) != len(q[j].legs) {
+		return len(q[i].legs) < len(q[j].legs)
+	}
+	return q[i].station < q[j].station
+}
+func (q journeyQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *journeyQueue) Push(x any)   { *q = append(*q, x.(*journeyStep)) }
+func (q *journeyQueue) Pop() any {
+	old := *q
+	step := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return step
+}
+
+// FindJourney returns the cheapest sequence of at most MaxLegs legs from one
+// station to another, preferring fewer connections between equal fares.
+func (g *RouteGraph) FindJourney(from, to string) ([]JourneyLeg, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	maxLegs := g.MaxLegs
+	if maxLegs <= 0 {
+		maxLegs = DefaultMaxLegs
+	}
+
+	// best records the cheapest fare seen per station and leg count, so a
+	// dearer path with fewer legs can still be explored.
+	best := make(map[string]map[int]int64)
+	queue := &journeyQueue{{station: from}}
+	for queue.Len() > 0 {
+		step := heap.Pop(queue).(*journeyStep)
+		if step.station == to && len(step.legs) > 0 {
+			return step.legs, nil
+		}
+		if len(step.legs) == maxLegs {
+			continue
+		}
+
+		for _, leg := range g.legs[step.station] {
+			fare := step.fare + g.legFare(leg)
+			count := len(step.legs) + 1
+			if seen, ok := best[leg.To][count]; ok && seen <= fare {
+				continue
+			}
+			if best[leg.To] == nil {
+				best[leg.To] = make(map[int]int64)
+			}
+			best[leg.To][count] = fare
+
+			legs := append(append([]JourneyLeg(nil), step.legs...), JourneyLeg{From: leg.From, To: leg.To, Fare: g.legFare(leg)})
+			heap.Push(queue, &journeyStep{station: leg.To, fare: fare, legs: legs})
+		}
+	}
+
+	return nil, fmt.Errorf("no route from %s to %s", from, to)
+}
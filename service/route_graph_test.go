@@ -0,0 +1,101 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRouteGraph(t *testing.T) {
+	graph := NewRouteGraph(map[string]int64{
+		"London-France": 2000,
+		"Invalid":       500,
+		"Paris-Lyon":    0,
+	})
+
+	legs := graph.Legs()
+	require.Len(t, legs, 1, "Connections without a separator or fare are skipped")
+	assert.Equal(t, RouteLeg{From: "London", To: "France", Fare: 2000}, legs[0])
+}
+
+func TestFindJourney(t *testing.T) {
+	graph := NewRouteGraph(map[string]int64{
+		"London-Paris":    5000,
+		"Paris-Brussels":  2000,
+		"London-Brussels": 8000,
+		"Brussels-Berlin": 4000,
+	})
+	graph.Tariff = Tariff{BaseFare: 500, PerKm: 10}
+	require.NoError(t, graph.AddLeg(RouteLeg{From: "Paris", To: "Lyon", Distance: 450}))
+
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		maxLegs   int
+		expected  []JourneyLeg
+		expectErr bool
+	}{
+		{
+			name:     "Direct leg",
+			from:     "London",
+			to:       "Paris",
+			expected: []JourneyLeg{{From: "London", To: "Paris", Fare: 5000}},
+		},
+		{
+			name: "Connection cheaper than direct leg",
+			from: "London",
+			to:   "Brussels",
+			expected: []JourneyLeg{
+				{From: "London", To: "Paris", Fare: 5000},
+				{From: "Paris", To: "Brussels", Fare: 2000},
+			},
+		},
+		{
+			name: "Distance based tariff",
+			from: "London",
+			to:   "Lyon",
+			expected: []JourneyLeg{
+				{From: "London", To: "Paris", Fare: 5000},
+				{From: "Paris", To: "Lyon", Fare: 5000},
+			},
+		},
+		{
+			name:    "Leg limit falls back to a dearer shorter journey",
+			from:    "London",
+			to:      "Berlin",
+			maxLegs: 2,
+			expected: []JourneyLeg{
+				{From: "London", To: "Brussels", Fare: 8000},
+				{From: "Brussels", To: "Berlin", Fare: 4000},
+			},
+		},
+		{
+			name:      "Direction matters",
+			from:      "Paris",
+			to:        "London",
+			expectErr: true,
+		},
+		{
+			name:      "Unknown station",
+			from:      "Chennai",
+			to:        "Coimbatore",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph.MaxLegs = tt.maxLegs
+			journey, err := graph.FindJourney(tt.from, tt.to)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, journey)
+		})
+	}
+}
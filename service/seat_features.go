@@ -0,0 +1,166 @@
+package service
+
+import (
+	"fmt"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+)
+
+// SeatFeature is a property of a seat that passengers can ask for.
+type SeatFeature string
+
+const (
+	FeatureWindow         SeatFeature = "window"
+	FeatureAisle          SeatFeature = "aisle"
+	FeatureForwardFacing  SeatFeature = "forward-facing"
+	FeatureBackwardFacing SeatFeature = "backward-facing"
+	FeatureTable          SeatFeature = "table"
+	FeatureQuietZone      SeatFeature = "quiet-zone"
+	FeaturePowerSocket    SeatFeature = "power-socket"
+)
+
+// seatFeatureProtos maps seat features to their API values.
+var seatFeatureProtos = map[SeatFeature]pb.SeatFeature{
+	FeatureWindow:         pb.SeatFeature_SEAT_FEATURE_WINDOW,
+	FeatureAisle:          pb.SeatFeature_SEAT_FEATURE_AISLE,
+	FeatureForwardFacing:  pb.SeatFeature_SEAT_FEATURE_FORWARD_FACING,
+	FeatureBackwardFacing: pb.SeatFeature_SEAT_FEATURE_BACKWARD_FACING,
+	FeatureTable:          pb.SeatFeature_SEAT_FEATURE_TABLE,
+	FeatureQuietZone:      pb.SeatFeature_SEAT_FEATURE_QUIET_ZONE,
+	FeaturePowerSocket:    pb.SeatFeature_SEAT_FEATURE_POWER_SOCKET,
+}
+
+// SeatRequest narrows down the seats a booking may get.
+type SeatRequest struct {
+	// Required features must all be present on every seat.
+	Required []SeatFeature
+	// Preferred features are met where possible. Earlier preferences weigh
+	// more: with n preferences the first scores n, the last scores 1.
+	Preferred []SeatFeature
+	// FareClass restricts seats to sections of that class; empty allows any.
+	FareClass string
+}
+
+// seatRequestFromProto converts API seat preferences, rejecting unknown features.
+func seatRequestFromProto(preferences *pb.SeatPreferences) (SeatRequest, error) {
+	required, err := seatFeaturesFromProto(preferences.GetRequired())
+	if err != nil {
+		return SeatRequest{}, err
+	}
+	preferred, err := seatFeaturesFromProto(preferences.GetPreferred())
+	if err != nil {
+		return SeatRequest{}, err
+	}
+	return SeatRequest{Required: required, Preferred: preferred}, nil
+}
+
+// seatFeaturesFromProto converts API seat features.
+func seatFeaturesFromProto(values []pb.SeatFeature) ([]SeatFeature, error) {
+	features := []SeatFeature{}
+	for _, value := range values {
+		feature, ok := seatFeatureFromProto(value)
+		if !ok {
+			return nil, fmt.Errorf("unknown seat feature %v", value)
+		}
+		features = append(features, feature)
+	}
+	return features, nil
+}
+
+// seatFeatureFromProto converts one API seat feature.
+func seatFeatureFromProto(value pb.SeatFeature) (SeatFeature, bool) {
+	for feature, proto := range seatFeatureProtos {
+		if proto == value {
+			return feature, true
+		}
+	}
+	return "", false
+}
+
+// seatFeaturesToProto converts seat features to their API values.
+func seatFeaturesToProto(features []SeatFeature) []pb.SeatFeature {
+	values := make([]pb.SeatFeature, 0, len(features))
+	for _, feature := range features {
+		values = append(values, seatFeatureProtos[feature])
+	}
+	return values
+}
+
+// ParseSeatFeature checks that a name is a known seat feature.
+func ParseSeatFeature(name string) (SeatFeature, error) {
+	if _, ok := seatFeatureProtos[SeatFeature(name)]; !ok {
+		return "", fmt.Errorf("unknown seat feature %q", name)
+	}
+	return SeatFeature(name), nil
+}
+
+// hasFeature reports whether features contains feature.
+func hasFeature(features []SeatFeature, feature SeatFeature) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// meets reports whether a seat with the given features meets every requirement.
+func (r SeatRequest) meets(features []SeatFeature) bool {
+	for _, required := range r.Required {
+		if !hasFeature(features, required) {
+			return false
+		}
+	}
+	return true
+}
+
+// score rates how well a seat with the given features matches the preferences.
+func (r SeatRequest) score(features []SeatFeature) int {
+	score := 0
+	for i, preferred := range r.Preferred {
+		if hasFeature(features, preferred) {
+			score += len(r.Preferred) - i
+		}
+	}
+	return score
+}
+
+// satisfied returns the preferences that a seat with the given features meets.
+func (r SeatRequest) satisfied(features []SeatFeature) []SeatFeature {
+	met := []SeatFeature{}
+	for _, preferred := range r.Preferred {
+		if hasFeature(features, preferred) {
+			met = append(met, preferred)
+		}
+	}
+	return met
+}
+
+// satisfiedEverywhere returns the preferences met by the seat on every leg, in
+// preference order.
+func satisfiedEverywhere(preferred []pb.SeatFeature, legs []*pb.Leg) []pb.SeatFeature {
+	met := []pb.SeatFeature{}
+	for _, feature := range preferred {
+		everywhere := true
+		for _, leg := range legs {
+			if !containsSeatFeature(leg.SatisfiedPreferences, feature) {
+				everywhere = false
+				break
+			}
+		}
+		if everywhere && !containsSeatFeature(met, feature) {
+			met = append(met, feature)
+		}
+	}
+	return met
+}
+
+// containsSeatFeature reports whether values contains value.
+func containsSeatFeature(values []pb.SeatFeature, value pb.SeatFeature) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// featureSections is a quiet coach A with window seats 1 and 4 and a table at
+// seats 3 and 4, and a coach B with power sockets at every seat.
+var featureSections = []SectionConfigs{
+	{
+		SectionName: "A",
+		MaxSeats:    4,
+		Features:    []SeatFeature{FeatureQuietZone},
+		SeatFeatures: map[int][]SeatFeature{
+			1: {FeatureWindow},
+			3: {FeatureTable},
+			4: {FeatureWindow, FeatureTable},
+		},
+	},
+	{SectionName: "B", MaxSeats: 2, Features: []SeatFeature{FeaturePowerSocket}},
+}
+
+func TestAssignMatchingSeats(t *testing.T) {
+	tests := []struct {
+		name      string
+		count     int
+		request   SeatRequest
+		expected  []SeatAssignment
+		expectErr bool
+	}{
+		{
+			name:     "Best match wins",
+			count:    1,
+			request:  SeatRequest{Preferred: []SeatFeature{FeatureWindow, FeatureTable}},
+			expected: []SeatAssignment{{Seat: 4, Section: "A"}},
+		},
+		{
+			name:     "Earlier preferences weigh more",
+			count:    1,
+			request:  SeatRequest{Preferred: []SeatFeature{FeatureTable, FeaturePowerSocket}},
+			expected: []SeatAssignment{{Seat: 3, Section: "A"}},
+		},
+		{
+			name:     "Requirements restrict the seats",
+			count:    1,
+			request:  SeatRequest{Required: []SeatFeature{FeaturePowerSocket}, Preferred: []SeatFeature{FeatureWindow}},
+			expected: []SeatAssignment{{Seat: 1, Section: "B"}},
+		},
+		{
+			name:     "Group keeps its weakest seat as good as possible",
+			count:    2,
+			request:  SeatRequest{Preferred: []SeatFeature{FeatureTable}},
+			expected: []SeatAssignment{{Seat: 3, Section: "A"}, {Seat: 4, Section: "A"}},
+		},
+		{
+			name:     "Group widens the choice when the best seats are too few",
+			count:    3,
+			request:  SeatRequest{Preferred: []SeatFeature{FeatureWindow}},
+			expected: []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 2, Section: "A"}, {Seat: 3, Section: "A"}},
+		},
+		{
+			name:      "Unmet requirement fails",
+			count:     1,
+			request:   SeatRequest{Required: []SeatFeature{FeatureWindow, FeaturePowerSocket}},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			seatManager := NewSeatManager(featureSections, NewMemoryStore())
+
+			seats, err := seatManager.AssignMatchingSeats("London", "France", tc.count, tc.request)
+			if tc.expectErr {
+				assert.EqualError(t, err, "no seats available with the required features")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, seats)
+		})
+	}
+}
+
+func TestPurchaseTicketWithSeatPreferences(t *testing.T) {
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager(featureSections, store), map[string]int64{"London-France": 2000}, store)
+
+	preferences := &pb.SeatPreferences{
+		Preferred: []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_WINDOW, pb.SeatFeature_SEAT_FEATURE_POWER_SOCKET},
+		Required:  []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_QUIET_ZONE},
+	}
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "quiet@example.com"}, From: "London", To: "France", SeatPreferences: preferences,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "A", receipt.Seat.Section)
+	assert.Equal(t, int32(1), receipt.Seat.SeatNumber)
+	assert.Equal(t, []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_WINDOW}, receipt.SatisfiedPreferences)
+	assert.Equal(t, []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_WINDOW}, receipt.Legs[0].SatisfiedPreferences)
+
+	t.Run("Changing seat updates the satisfied preferences", func(t *testing.T) {
+		updated, err := tm.ModifyUserSeat(context.Background(), &pb.ModifyUserSeatRequest{
+			BookingReference: receipt.BookingReference, NewSeat: &pb.Seat{SeatNumber: 2, Section: "B"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_POWER_SOCKET}, updated.SatisfiedPreferences)
+	})
+
+	t.Run("Unmet requirement fails", func(t *testing.T) {
+		_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{Email: "table@example.com"}, From: "London", To: "France",
+			SeatPreferences: &pb.SeatPreferences{Required: []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_BACKWARD_FACING}},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Unknown feature is rejected", func(t *testing.T) {
+		_, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+			User: &pb.User{Email: "odd@example.com"}, From: "London", To: "France",
+			SeatPreferences: &pb.SeatPreferences{Preferred: []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_UNSPECIFIED}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultHoldTTL is how long a hold lasts when the request gives no TTL.
+	DefaultHoldTTL = 10 * time.Minute
+	// MaxHoldTTL is the longest hold a request may ask for.
+	MaxHoldTTL = time.Hour
+)
+
+// journeyHold is a journey held for checkout. Each leg is held on its
+// departure's SeatManager under holdLegID(hold ID, leg index).
+// The booking has no passengers until the hold is confirmed.
+type journeyHold struct {
+	booking
+	legs      [][]*pb.Leg
+	expiresAt time.Time
+}
+
+// holdLegID returns the SeatManager hold ID of one leg of a journey hold. Two
+// legs may be held on the same departure, so each needs its own ID.
+func holdLegID(id string, leg int) string {
+	return fmt.Sprintf("%s/%d", id, leg)
+}
+
+// HoldSeats holds seats on every leg of a journey for a limited time while the
+// customer pays. The hold is turned into a booking by ConfirmHold or by
+// PurchaseTicket with its hold ID.
+func (t *TicketManager) HoldSeats(ctx context.Context, req *pb.HoldSeatsRequest) (*pb.SeatHold, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("HoldSeats request received: %+v", req)
+
+	if req.From == "" || req.To == "" {
+		log.Printf("HoldSeats request missing required fields: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("HoldSeats request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+
+	count := int(req.SeatCount)
+	if count == 0 {
+		count = 1
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl == 0 {
+		ttl = DefaultHoldTTL
+	}
+	if count < 0 || ttl < 0 || ttl > MaxHoldTTL {
+		log.Printf("HoldSeats request with invalid seat count or ttl: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "invalid seat count or ttl")
+	}
+
+	held := booking{
+		from:        req.From,
+		to:          req.To,
+		preferences: req.SeatPreferences,
+		fareClass:   fareClassOrDefault(req.FareClass),
+	}
+	request, err := held.seatRequest()
+	if err != nil {
+		log.Printf("HoldSeats request with invalid seat preferences: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid seat preferences")
+	}
+
+	journey, err := t.Routes.FindJourney(req.From, req.To)
+	if err != nil {
+		log.Printf("HoldSeats request with invalid station: From=%s, To=%s", req.From, req.To)
+		return nil, status.Error(codes.InvalidArgument, "invalid station")
+	}
+
+	departure, ok := t.departure(req.DepartureId)
+	if !ok {
+		log.Printf("HoldSeats request with unknown departure: %s", req.DepartureId)
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+
+	id, err := newReference(func(id string) bool {
+		_, taken := t.holds["HOLD-"+id]
+		return taken
+	})
+	if err != nil {
+		log.Printf("HoldSeats hold id failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create hold")
+	}
+	id = "HOLD-" + id
+	expiresAt := time.Now().Add(ttl)
+
+	hold := func(seats *SeatManager, index int, leg JourneyLeg) ([]SeatAssignment, error) {
+		return seats.HoldSeats(holdLegID(id, index), leg.From, leg.To, count, request, expiresAt)
+	}
+	rollback := func(booked [][]*pb.Leg) {
+		t.releaseHoldLegs(id, booked[0], 0)
+	}
+	booked, err := t.seatJourney(departure, req.DepartureId != "", journey, count, request, hold, rollback)
+	if err != nil {
+		log.Printf("HoldSeats seat hold failed: %v", err)
+		return nil, err
+	}
+
+	t.putHold(id, &journeyHold{booking: held, legs: booked, expiresAt: expiresAt})
+
+	response := t.holdToProto(id, t.holds[id])
+	log.Printf("HoldSeats successful: %+v", response)
+	return response, nil
+}
+
+// ConfirmHold books the seats of an unexpired hold for the given passengers,
+// one per held seat, and returns the receipt.
+func (t *TicketManager) ConfirmHold(ctx context.Context, req *pb.ConfirmHoldRequest) (*pb.TicketReceipt, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ConfirmHold request received: %+v", req)
+
+	if req.HoldId == "" || len(req.Passengers) == 0 || req.Passengers[0].GetEmail() == "" {
+		log.Printf("ConfirmHold request missing required fields: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+	for _, passenger := range req.Passengers {
+		if passenger == nil {
+			log.Printf("ConfirmHold request with empty passenger: %+v", req)
+			return nil, status.Error(codes.InvalidArgument, "missing required fields")
+		}
+	}
+
+	return t.confirmHold(ctx, req.HoldId, req.Passengers, req.PromoCodes, req.PaymentToken)
+}
+
+// confirmHold turns a hold into a booking for passengers, discounted by the
+// given promo codes and paid for with the payment token. The held seats are
+// only assigned once the payment is taken. Callers must hold t.mu.
+func (t *TicketManager) confirmHold(ctx context.Context, id string, passengers []*pb.User, promoCodes []string, paymentToken string) (*pb.TicketReceipt, error) {
+	hold, ok := t.holds[id]
+	if !ok {
+		log.Printf("ConfirmHold hold not found: %s", id)
+		return nil, status.Error(codes.NotFound, "hold not found")
+	}
+	if !time.Now().Before(hold.expiresAt) {
+		log.Printf("ConfirmHold hold expired: %s", id)
+		t.dropHold(id)
+		t.promoteWaitlists()
+		return nil, status.Error(codes.FailedPrecondition, "hold has expired")
+	}
+	if seatedPassengers(passengers) != len(hold.legs) {
+		log.Printf("ConfirmHold passenger count %d does not match hold %s", len(passengers), id)
+		return nil, status.Errorf(codes.InvalidArgument, "hold is for %d seated passengers", len(hold.legs))
+	}
+	departure, _ := t.departure(hold.legs[0][0].DepartureId)
+	if err := t.validatePassengers(passengers, departure.travelDate(time.Now())); err != nil {
+		log.Printf("ConfirmHold passenger rejected: %v", err)
+		return nil, err
+	}
+
+	confirmed := hold.booking
+	confirmed.passengers = passengers
+	confirmed.promoCodes = promoCodes
+	confirmed.paymentToken = paymentToken
+	discounts, err := t.validPromoCodes(confirmed, time.Now())
+	if err != nil {
+		log.Printf("ConfirmHold promo code rejected: %v", err)
+		return nil, err
+	}
+	confirmed.discounts = discounts
+
+	receipt, err := t.newReceipt(confirmed, hold.legs)
+	if err != nil {
+		log.Printf("ConfirmHold booking reference failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to create booking reference")
+	}
+
+	// The seats stay held while the booking is paid for, so a failed
+	// payment leaves the hold to confirm again.
+	if err := t.payForReceipt(ctx, receipt, paymentToken); err != nil {
+		log.Printf("ConfirmHold payment failed: %v", err)
+		return nil, err
+	}
+	if t.holds[id] != hold {
+		log.Printf("ConfirmHold hold %s ended during payment", id)
+		t.refundPayment(ctx, receipt.PaymentId, receipt.Price)
+		return nil, status.Error(codes.FailedPrecondition, "hold has expired")
+	}
+
+	for i, leg := range hold.legs[0] {
+		seats, err := t.legSeats(leg)
+		if err == nil {
+			err = seats.ConfirmHold(holdLegID(id, i))
+		}
+		if err != nil {
+			log.Printf("ConfirmHold seat confirmation failed: %v", err)
+			t.refundPayment(ctx, receipt.PaymentId, receipt.Price)
+			// Legs before i are assigned now and the rest are still held.
+			confirmed := make([][]*pb.Leg, len(hold.legs))
+			for p, legs := range hold.legs {
+				confirmed[p] = legs[:i]
+			}
+			if releaseErr := t.releasePassengerLegs(confirmed); releaseErr != nil {
+				log.Printf("ConfirmHold seat release failed: %v", releaseErr)
+			}
+			t.releaseHoldLegs(id, hold.legs[0][i:], i)
+			t.deleteHold(id)
+			return nil, status.Error(codes.Internal, "failed to confirm hold")
+		}
+	}
+	t.deleteHold(id)
+
+	return t.storeReceipt(ctx, receipt, hold.legs)
+}
+
+// ReleaseHold gives up a hold and makes its seats available again.
+func (t *TicketManager) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.ReleaseHoldResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ReleaseHold request received: %+v", req)
+
+	if _, ok := t.holds[req.HoldId]; !ok {
+		log.Printf("ReleaseHold hold not found: %s", req.HoldId)
+		return nil, status.Error(codes.NotFound, "hold not found")
+	}
+
+	t.dropHold(req.HoldId)
+	t.promoteWaitlists()
+
+	log.Printf("ReleaseHold successful: %s", req.HoldId)
+	return &pb.ReleaseHoldResponse{Message: "Hold released successfully"}, nil
+}
+
+// RunHoldSweeper releases expired holds every interval until ctx is done.
+func (t *TicketManager) RunHoldSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			t.sweepHolds(now)
+		}
+	}
+}
+
+// sweepHolds returns the seats of every hold that expired at or before now to
+// "Available" and forgets expired price quotes. A seat hold that fails to
+// release stays with its SeatManager and is retried on the next sweep.
+func (t *TicketManager) sweepHolds(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, departure := range t.Departures {
+		released, err := departure.SeatManager.ReleaseExpiredHolds(now)
+		if err != nil {
+			log.Printf("Hold sweep on departure %s failed: %v", departure.ID, err)
+		}
+		if len(released) > 0 {
+			log.Printf("Hold sweep released %v on departure %s", released, departure.ID)
+		}
+	}
+	for id, hold := range t.holds {
+		if !hold.expiresAt.After(now) {
+			t.deleteHold(id)
+		}
+	}
+	for id, quote := range t.quotes {
+		if !quote.expiresAt.After(now) {
+			delete(t.quotes, id)
+		}
+	}
+	t.promoteWaitlists()
+}
+
+// dropHold releases the seats of a hold and forgets it. Callers must hold t.mu.
+func (t *TicketManager) dropHold(id string) {
+	t.releaseHoldLegs(id, t.holds[id].legs[0], 0)
+	t.deleteHold(id)
+}
+
+// releaseHoldLegs releases the seat holds of the legs of one held seat, where
+// legs[0] is leg number first of the journey.
+func (t *TicketManager) releaseHoldLegs(id string, legs []*pb.Leg, first int) {
+	for i, leg := range legs {
+		seats, err := t.legSeats(leg)
+		if err == nil {
+			err = seats.ReleaseHold(holdLegID(id, first+i))
+		}
+		if err != nil {
+			log.Printf("Releasing hold %s failed: %v", id, err)
+		}
+	}
+}
+
+// holdToProto converts a hold to its API representation.
+func (t *TicketManager) holdToProto(id string, hold *journeyHold) *pb.SeatHold {
+	response := &pb.SeatHold{
+		HoldId:    id,
+		From:      hold.from,
+		To:        hold.to,
+		ExpiresAt: timestamppb.New(hold.expiresAt),
+	}
+	for _, legs := range hold.legs {
+		response.Seats = append(response.Seats, &pb.Passenger{Legs: legs})
+	}
+	response.Price = t.totalPrice(flattenLegs(hold.legs))
+	return response
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHoldAndConfirm(t *testing.T) {
+	tests := []struct {
+		name    string
+		confirm func(tm *TicketManager, hold *pb.SeatHold) (*pb.TicketReceipt, error)
+	}{
+		{
+			name: "ConfirmHold",
+			confirm: func(tm *TicketManager, hold *pb.SeatHold) (*pb.TicketReceipt, error) {
+				return tm.ConfirmHold(context.Background(), &pb.ConfirmHoldRequest{
+					HoldId:     hold.HoldId,
+					Passengers: []*pb.User{{FirstName: "Hold", Email: "hold@example.com"}},
+				})
+			},
+		},
+		{
+			name: "PurchaseTicket with hold",
+			confirm: func(tm *TicketManager, hold *pb.SeatHold) (*pb.TicketReceipt, error) {
+				return tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+					HoldId: hold.HoldId,
+					User:   &pb.User{FirstName: "Hold", Email: "hold@example.com"},
+				})
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tm := createConnectingTicketManager(t, 5)
+
+			hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "Brussels", DepartureId: "LP-0900"})
+			require.NoError(t, err)
+			require.Len(t, hold.Seats, 1)
+			require.Len(t, hold.Seats[0].Legs, 2)
+			assert.Equal(t, int64(7000), hold.Price.GetMinorUnits())
+			assert.WithinDuration(t, time.Now().Add(DefaultHoldTTL), hold.ExpiresAt.AsTime(), time.Minute)
+
+			for _, leg := range hold.Seats[0].Legs {
+				departure, _ := tm.departure(leg.DepartureId)
+				assert.Equal(t, "Held", departure.SeatManager.Sections[leg.Seat.Section].SeatState(int(leg.Seat.SeatNumber)))
+			}
+
+			receipt, err := tc.confirm(tm, hold)
+			require.NoError(t, err)
+			assert.Equal(t, hold.Seats[0].Legs, receipt.Legs)
+			assert.Equal(t, int64(7000), receipt.Price.GetMinorUnits())
+			for _, leg := range receipt.Legs {
+				departure, _ := tm.departure(leg.DepartureId)
+				assert.Equal(t, "Assigned", departure.SeatManager.Sections[leg.Seat.Section].SeatState(int(leg.Seat.SeatNumber)))
+			}
+
+			_, err = tc.confirm(tm, hold)
+			assert.Equal(t, codes.NotFound, status.Code(err), "A hold can only be confirmed once")
+		})
+	}
+}
+
+func TestHoldExpiry(t *testing.T) {
+	t.Run("Sweeper releases expired holds", func(t *testing.T) {
+		tm := createTestTicketManager()
+
+		hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "France", SeatCount: 2, TtlSeconds: 60})
+		require.NoError(t, err)
+		require.Len(t, hold.Seats, 2)
+
+		tm.sweepHolds(time.Now())
+		assert.Contains(t, tm.holds, hold.HoldId, "Hold has not expired yet")
+
+		tm.sweepHolds(time.Now().Add(2 * time.Minute))
+		assert.NotContains(t, tm.holds, hold.HoldId)
+		for _, seat := range hold.Seats {
+			seatNumber := int(seat.Legs[0].Seat.SeatNumber)
+			assert.Equal(t, "Available", tm.SeatManager.Sections[seat.Legs[0].Seat.Section].SeatState(seatNumber))
+		}
+
+		_, err = tm.ConfirmHold(context.Background(), &pb.ConfirmHoldRequest{
+			HoldId:     hold.HoldId,
+			Passengers: []*pb.User{{Email: "a@example.com"}, {Email: "b@example.com"}},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Confirming an expired hold fails", func(t *testing.T) {
+		tm := createTestTicketManager()
+
+		hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "France"})
+		require.NoError(t, err)
+		tm.holds[hold.HoldId].expiresAt = time.Now().Add(-time.Second)
+
+		_, err = tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{HoldId: hold.HoldId, User: &pb.User{Email: "late@example.com"}})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		seat := hold.Seats[0].Legs[0].Seat
+		assert.Equal(t, "Available", tm.SeatManager.Sections[seat.Section].SeatState(int(seat.SeatNumber)))
+	})
+}
+
+func TestReleaseHold(t *testing.T) {
+	tm := createTestTicketManager()
+
+	hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "France"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		holdID     string
+		expectCode codes.Code
+	}{
+		{name: "Release held seats", holdID: hold.HoldId, expectCode: codes.OK},
+		{name: "Release twice", holdID: hold.HoldId, expectCode: codes.NotFound},
+		{name: "Unknown hold", holdID: "HOLD-NOPE", expectCode: codes.NotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tm.ReleaseHold(context.Background(), &pb.ReleaseHoldRequest{HoldId: tc.holdID})
+			assert.Equal(t, tc.expectCode, status.Code(err))
+		})
+	}
+
+	seat := hold.Seats[0].Legs[0].Seat
+	assert.Equal(t, "Available", tm.SeatManager.Sections[seat.Section].SeatState(int(seat.SeatNumber)))
+}
+
+func TestHoldSeatsValidation(t *testing.T) {
+	tm := createTestTicketManager()
+
+	tests := []struct {
+		name    string
+		request *pb.HoldSeatsRequest
+	}{
+		{name: "Missing stations", request: &pb.HoldSeatsRequest{From: "London"}},
+		{name: "Negative seat count", request: &pb.HoldSeatsRequest{From: "London", To: "France", SeatCount: -1}},
+		{name: "TTL too long", request: &pb.HoldSeatsRequest{From: "London", To: "France", TtlSeconds: int32(2 * MaxHoldTTL / time.Second)}},
+		{name: "Unknown station", request: &pb.HoldSeatsRequest{From: "London", To: "Mars"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tm.HoldSeats(context.Background(), tc.request)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+	assert.Empty(t, tm.holds)
+}
@@ -2,82 +2,583 @@ package service
 
 import (
 	"fmt"
+	"sort"
 	"sync"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
 )
 
 // SeatManager handles the assignment, release, and modification of seats.
-// It manages seats across different sections in a round-robin manner.
+// Its allocation strategy decides which free seat each booking gets; by
+// default it rotates across sections in a round-robin manner.
+//
+// A departure calls at an ordered list of stops, and every seat is tracked per
+// segment between consecutive stops. A seat sold for part of the route stays
+// available on the segments it does not cover. Without stops the route is a
+// single segment and every booking occupies it.
+//
+// Seats can also be "Held" for a while before they are paid for. A held seat
+// cannot be assigned to anyone else until the hold is confirmed, released or
+// expires.
 type SeatManager struct {
 	Sections    map[string]*Section
+	Stops       []string
 	mu          sync.Mutex
 	nextSections []string
 	nextSection int
+	store       Store
+	departureID string
+	holds       map[string]*SeatHold
+	strategy    AllocationStrategy
+	// feed numbers seat changes and sends them to availability watchers.
+	feed        *availabilityFeed
 }
 
 type Section struct {
 	Name           string
 	MaxSeats       int
-	AvailableSeats map[int]string
+	// Occupancy holds the state of each seat on every segment, where
+	// segment i runs from stop i to stop i+1.
+	Occupancy map[int][]string
+	// Features lists the features of each seat that has any.
+	Features map[int][]SeatFeature
+	// Layout arranges the seats in rows, or is nil for a section configured
+	// only by its number of seats.
+	Layout *CoachLayout
+	// FareClass is the class every seat in the section is sold in.
+	FareClass string
+	// Closed sections take no new bookings; seats already booked are kept.
+	Closed bool
+	// features apply to every seat of the section, including seats added
+	// by a resize.
+	features []SeatFeature
+	// administered is set on sections added or resized with AdminService,
+	// which a config reload or restart keeps as they are.
+	administered bool
 }
 
 type SectionConfigs struct {
 	SectionName string
 	MaxSeats    int
+	// Layout arranges the seats in rows. When set, the section has as many
+	// seats as the layout and MaxSeats is ignored; window and aisle seats
+	// get those features.
+	Layout *CoachLayout
+	// Features apply to every seat in the section, such as a quiet coach.
+	Features []SeatFeature
+	// SeatFeatures adds features to individual seats.
+	SeatFeatures map[int][]SeatFeature
+	// FareClass is the class the section's seats are sold in, such as
+	// FareClassFirst. Empty means FareClassStandard.
+	FareClass string
+}
+
+// seatCount returns the number of seats a section config describes.
+func (c SectionConfigs) seatCount() int {
+	if c.Layout != nil {
+		return c.Layout.SeatCount()
+	}
+	return c.MaxSeats
+}
+
+// SeatFeatures returns the features of a seat.
+func (sec *Section) SeatFeatures(seat int) []SeatFeature {
+	return sec.Features[seat]
 }
 
+// SeatHold is a set of seats held from one stop to another until ExpiresAt.
+type SeatHold struct {
+	ID        string
+	From      string
+	To        string
+	Seats     []SeatAssignment
+	ExpiresAt time.Time
+}
+
+// SeatState summarizes a seat over the whole route: "Assigned" if it is
+// assigned on any segment, otherwise "Held" if it is held on any segment,
+// otherwise "Blocked" if it is out of sale, otherwise "Available".
+func (sec *Section) SeatState(seat int) string {
+	return rangeState(sec.Occupancy[seat], 0, len(sec.Occupancy[seat]))
+}
+
+
+// NewSeatManager initializes a new SeatManager with predefined sections and seats
+// on a single-segment route. Seat changes are committed to the given store.
+func NewSeatManager(sectionConfigs []SectionConfigs, store Store) *SeatManager {
+	return NewRouteSeatManager(sectionConfigs, nil, store)
+}
 
-// NewSeatManager initializes a new SeatManager with predefined sections and seats.
-func NewSeatManager(sectionConfigs []SectionConfigs) *SeatManager {
+// NewRouteSeatManager initializes a new SeatManager whose seats are tracked per
+// segment between the given stops.
+func NewRouteSeatManager(sectionConfigs []SectionConfigs, stops []string, store Store) *SeatManager {
+	segments := len(stops) - 1
+	if segments < 1 {
+		segments = 1
+	}
 
 	sections := make(map[string]*Section)
 	nextSections := []string{}
 	for _, sectionConfig := range sectionConfigs {
-		sections[sectionConfig.SectionName] = &Section{
-			Name: sectionConfig.SectionName,
-			MaxSeats: sectionConfig.MaxSeats,
-			AvailableSeats: initializeSeats(sectionConfig.MaxSeats),
-		}
+		sections[sectionConfig.SectionName] = newSection(sectionConfig, segments)
 		nextSections = append(nextSections, sectionConfig.SectionName)
 	}
 
 	return &SeatManager{
 		Sections: sections,
+		Stops: stops,
 		nextSections: nextSections,
 		nextSection: 0,
+		store: store,
+		holds: make(map[string]*SeatHold),
+		strategy: roundRobinWithFallback{},
+		feed: newAvailabilityFeed(),
 	}
 }
 
-// initializeSeats creates a map of seats marked as "Available".
-func initializeSeats(count int) map[int]string {
-	seats := make(map[int]string)
+// SetStrategy changes how seats are chosen for later bookings.
+func (s *SeatManager) SetStrategy(strategy AllocationStrategy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.strategy = strategy
+}
+
+// Strategy returns the seat allocation strategy in use.
+func (s *SeatManager) Strategy() AllocationStrategy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.strategy
+}
+
+// newSection creates a section with every seat available on each of the given
+// number of segments.
+func newSection(config SectionConfigs, segments int) *Section {
+	return &Section{
+		Name: config.SectionName,
+		MaxSeats: config.seatCount(),
+		Occupancy: initializeSeats(config.seatCount(), segments),
+		Features: initializeFeatures(config),
+		Layout: config.Layout,
+		FareClass: fareClassOrDefault(config.FareClass),
+		features: config.Features,
+	}
+}
+
+// initializeSeats creates a map of seats marked as "Available" on every segment.
+func initializeSeats(count int, segments int) map[int][]string {
+	seats := make(map[int][]string)
 	for i := 1; i <= count; i++ {
-		seats[i] = "Available"
+		seats[i] = make([]string, segments)
+		for j := range seats[i] {
+			seats[i][j] = "Available"
+		}
 	}
 	return seats
 }
 
-// AssignSeat assigns the next available seat in a round-robin manner.
-func (s *SeatManager) AssignSeat() (int, string, error) {
+// initializeFeatures combines the section-wide, layout and per-seat features
+// of a section into the features of each seat.
+func initializeFeatures(config SectionConfigs) map[int][]SeatFeature {
+	layoutFeatures := map[int][]SeatFeature{}
+	if config.Layout != nil {
+		layoutFeatures = config.Layout.features()
+	}
+
+	features := make(map[int][]SeatFeature)
+	for seat := 1; seat <= config.seatCount(); seat++ {
+		seatFeatures := append([]SeatFeature(nil), config.Features...)
+		for _, feature := range append(layoutFeatures[seat], config.SeatFeatures[seat]...) {
+			if !hasFeature(seatFeatures, feature) {
+				seatFeatures = append(seatFeatures, feature)
+			}
+		}
+		if len(seatFeatures) > 0 {
+			features[seat] = seatFeatures
+		}
+	}
+	return features
+}
+
+// segmentRange returns the half-open range of segments travelled from one stop
+// to another. On a route without stops the whole single segment is returned.
+func (s *SeatManager) segmentRange(from, to string) (int, int, error) {
+	if len(s.Stops) < 2 {
+		return 0, 1, nil
+	}
+
+	start, end := -1, -1
+	for i, stop := range s.Stops {
+		if stop == from {
+			start = i
+		}
+		if stop == to {
+			end = i
+		}
+	}
+
+	if start < 0 || end < 0 {
+		return 0, 0, fmt.Errorf("stations %s-%s are not on the route", from, to)
+	}
+	if start >= end {
+		return 0, 0, fmt.Errorf("station %s is not before %s on the route", from, to)
+	}
+	return start, end, nil
+}
+
+// segmentsIn reports whether every segment in [start, end) has the given state.
+func segmentsIn(segments []string, start, end int, state string) bool {
+	if end > len(segments) {
+		return false
+	}
+	for _, current := range segments[start:end] {
+		if current != state {
+			return false
+		}
+	}
+	return true
+}
+
+// segmentsTaken reports whether any segment in [start, end) is held or
+// assigned. Blocked seats are out of sale, not taken.
+func segmentsTaken(segments []string, start, end int) bool {
+	for i := start; i < end && i < len(segments); i++ {
+		if segments[i] == "Held" || segments[i] == "Assigned" {
+			return true
+		}
+	}
+	return false
+}
+
+// withSegments returns a copy of segments with [start, end) set to state.
+func withSegments(segments []string, start, end int, state string) []string {
+	updated := append([]string(nil), segments...)
+	for i := start; i < end; i++ {
+		updated[i] = state
+	}
+	return updated
+}
+
+// SeatFeatures returns the features of a seat in a section.
+func (s *SeatManager) SeatFeatures(section string, seat int) []SeatFeature {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	section := s.Sections[s.nextSections[s.nextSection]]
+	if sec, ok := s.Sections[section]; ok {
+		return sec.SeatFeatures(seat)
+	}
+	return nil
+}
+
+// SectionFareClass returns the fare class of a section.
+func (s *SeatManager) SectionFareClass(section string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	for seat, available := range section.AvailableSeats {
-		if available == "Available" {
-			section.AvailableSeats[seat] = "Assigned"
+	if sec, ok := s.Sections[section]; ok {
+		return sec.FareClass, true
+	}
+	return "", false
+}
+
+// ClassOccupancy returns how many seats of a fare class are held or assigned
+// on any segment from one stop to another, and how many seats the class has.
+// An empty class counts every section.
+func (s *SeatManager) ClassOccupancy(from, to, class string) (int, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start, end, err := s.segmentRange(from, to)
+	if err != nil {
+		return 0, 0, err
+	}
 
-			// Simple round-robin to assign seats
-			s.nextSection = (s.nextSection + 1) % len(s.nextSections)
-			return seat, section.Name, nil
+	occupied, capacity := 0, 0
+	for _, section := range s.Sections {
+		if class != "" && section.FareClass != class {
+			continue
 		}
+		capacity += section.MaxSeats
+		for seat := 1; seat <= section.MaxSeats; seat++ {
+			if segmentsTaken(section.Occupancy[seat], start, end) {
+				occupied++
+			}
+		}
+	}
+	return occupied, capacity, nil
+}
+
+// AssignSeat assigns a seat that is available from one stop to another, chosen
+// by the allocation strategy.
+func (s *SeatManager) AssignSeat(from, to string) (int, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	assignments, err := s.assignSeats(from, to, 1, "Assigned", SeatRequest{})
+	if err != nil {
+		return 0, "", err
 	}
+	return assignments[0].Seat, assignments[0].Section, nil
+}
 
-	return 0, "", fmt.Errorf("no seats available")
+// SeatAssignment identifies a seat within a section.
+type SeatAssignment struct {
+	Seat    int
+	Section string
 }
 
-// ReleaseSeat releases an assigned seat, making it available again.
-func (s *SeatManager) ReleaseSeat(seat int, seatSection string) error {
+// AssignSeats assigns count seats from one stop to another as a single unit:
+// either every seat is assigned or none is. The built-in strategies prefer a
+// run of consecutive seat numbers in one section, then any seats within one
+// section, and only then split the group across sections.
+func (s *SeatManager) AssignSeats(from, to string, count int) ([]SeatAssignment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.assignSeats(from, to, count, "Assigned", SeatRequest{})
+}
+
+// AssignMatchingSeats assigns count seats as AssignSeats does, restricted to
+// seats with every required feature. Among those it picks the seats that best
+// match the preferences: the group's lowest-scoring seat scores as high as
+// possible, and the allocation strategy breaks ties.
+func (s *SeatManager) AssignMatchingSeats(from, to string, count int, request SeatRequest) ([]SeatAssignment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.assignSeats(from, to, count, "Assigned", request)
+}
+
+// assignSeats puts count seats matching request into the given state from one
+// stop to another, choosing them as AssignMatchingSeats does. Callers must
+// hold s.mu.
+func (s *SeatManager) assignSeats(from, to string, count int, state string, request SeatRequest) ([]SeatAssignment, error) {
+	if count <= 0 {
+		return nil, fmt.Errorf("seat count must be positive")
+	}
+
+	start, end, err := s.segmentRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// Free seats per section, in ascending seat order, with their scores.
+	free := 0
+	scores := map[int]bool{}
+	seatScores := make(map[SeatAssignment]int)
+	sectionViews := []SectionView{}
+	for _, name := range s.nextSections {
+		section := s.Sections[name]
+		sectionView := SectionView{Name: name, MaxSeats: section.MaxSeats}
+		if (request.FareClass != "" && section.FareClass != request.FareClass) || section.Closed {
+			// Other classes and closed sections stay in the view, without
+			// free seats, so the strategy's round-robin position still
+			// lines up.
+			sectionView.Occupied = section.MaxSeats
+			sectionViews = append(sectionViews, sectionView)
+			continue
+		}
+		for seat := 1; seat <= section.MaxSeats; seat++ {
+			segments, ok := section.Occupancy[seat]
+			if !ok || !segmentsIn(segments, start, end, "Available") {
+				if segmentsTaken(segments, start, end) {
+					sectionView.Occupied++
+				}
+				continue
+			}
+			free++
+			if !request.meets(section.Features[seat]) {
+				continue
+			}
+			score := request.score(section.Features[seat])
+			scores[score] = true
+			seatScores[SeatAssignment{Seat: seat, Section: name}] = score
+			sectionView.Free = append(sectionView.Free, seat)
+		}
+		sectionViews = append(sectionViews, sectionView)
+	}
+
+	// Offer the strategy only the best-scoring seats, widening the choice
+	// until the group fits.
+	levels := []int{}
+	for score := range scores {
+		levels = append(levels, score)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(levels)))
+
+	var chosen []SeatAssignment
+	var view AllocationView
+	for _, level := range levels {
+		view = AllocationView{Next: s.nextSection}
+		for _, sectionView := range sectionViews {
+			atLevel := sectionView
+			atLevel.Free = nil
+			for _, seat := range sectionView.Free {
+				if seatScores[SeatAssignment{Seat: seat, Section: sectionView.Name}] >= level {
+					atLevel.Free = append(atLevel.Free, seat)
+				}
+			}
+			view.Sections = append(view.Sections, atLevel)
+		}
+		if chosen = s.strategy.Allocate(view, count); len(chosen) == count {
+			break
+		}
+	}
+
+	if len(chosen) != count {
+		if free >= count {
+			return nil, fmt.Errorf("no seats available with the required features")
+		}
+		if count == 1 {
+			return nil, fmt.Errorf("no seats available")
+		}
+		return nil, fmt.Errorf("not enough seats available")
+	}
+	if err := validateAllocation(view, chosen); err != nil {
+		return nil, fmt.Errorf("allocation strategy %s: %w", s.strategy.Name(), err)
+	}
+
+	mutation := &Mutation{Seats: map[string]map[int][]string{}}
+	updates := make([][]string, len(chosen))
+	for i, assignment := range chosen {
+		key := departureStoreKey(s.departureID, assignment.Section)
+		if mutation.Seats[key] == nil {
+			mutation.Seats[key] = map[int][]string{}
+		}
+		updates[i] = withSegments(s.Sections[assignment.Section].Occupancy[assignment.Seat], start, end, state)
+		mutation.Seats[key][assignment.Seat] = updates[i]
+	}
+	if err := s.store.Commit(mutation); err != nil {
+		return nil, fmt.Errorf("persist seat change: %w", err)
+	}
+
+	for i, assignment := range chosen {
+		s.Sections[assignment.Section].Occupancy[assignment.Seat] = updates[i]
+	}
+	kind := pb.SeatEventKind_SEAT_EVENT_KIND_ASSIGNED
+	if state == "Held" {
+		kind = pb.SeatEventKind_SEAT_EVENT_KIND_HELD
+	}
+	s.publish(kind, from, to, chosen)
+
+	// Round-robin resumes after the section booked into.
+	for i, name := range s.nextSections {
+		if name == chosen[0].Section {
+			s.nextSection = (i + 1) % len(s.nextSections)
+		}
+	}
+
+	return chosen, nil
+}
+
+// HoldSeats holds count seats from one stop to another under the given hold ID
+// until expiresAt, choosing them as AssignMatchingSeats does. Held seats are not
+// offered to anyone else until the hold is confirmed or released.
+func (s *SeatManager) HoldSeats(id, from, to string, count int, request SeatRequest, expiresAt time.Time) ([]SeatAssignment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.holds[id]; ok {
+		return nil, fmt.Errorf("hold %s already exists", id)
+	}
+
+	seats, err := s.assignSeats(from, to, count, "Held", request)
+	if err != nil {
+		return nil, err
+	}
+
+	s.holds[id] = &SeatHold{ID: id, From: from, To: to, Seats: seats, ExpiresAt: expiresAt}
+	return seats, nil
+}
+
+// ConfirmHold turns the seats of a hold into assigned seats and forgets the hold.
+func (s *SeatManager) ConfirmHold(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.endHold(id, "Assigned")
+}
+
+// ReleaseHold makes the seats of a hold available again and forgets the hold.
+func (s *SeatManager) ReleaseHold(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.endHold(id, "Available")
+}
+
+// ReleaseExpiredHolds releases every hold that expired at or before now and
+// returns their IDs. Holds that fail to release are kept and retried on the
+// next call.
+func (s *SeatManager) ReleaseExpiredHolds(now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	released := []string{}
+	var firstErr error
+	for id, hold := range s.holds {
+		if hold.ExpiresAt.After(now) {
+			continue
+		}
+		if err := s.endHold(id, "Available"); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		released = append(released, id)
+	}
+	return released, firstErr
+}
+
+// endHold moves the held seats of a hold into the given state and removes the
+// hold. Callers must hold s.mu.
+func (s *SeatManager) endHold(id string, state string) error {
+	hold, ok := s.holds[id]
+	if !ok {
+		return fmt.Errorf("hold %s not found", id)
+	}
+
+	start, end, err := s.segmentRange(hold.From, hold.To)
+	if err != nil {
+		return err
+	}
+
+	mutation := &Mutation{Seats: map[string]map[int][]string{}}
+	updates := make([][]string, len(hold.Seats))
+	for i, assignment := range hold.Seats {
+		segments := s.Sections[assignment.Section].Occupancy[assignment.Seat]
+		if !segmentsIn(segments, start, end, "Held") {
+			return fmt.Errorf("seat %d in section %s is not held", assignment.Seat, assignment.Section)
+		}
+		key := departureStoreKey(s.departureID, assignment.Section)
+		if mutation.Seats[key] == nil {
+			mutation.Seats[key] = map[int][]string{}
+		}
+		updates[i] = withSegments(segments, start, end, state)
+		mutation.Seats[key][assignment.Seat] = updates[i]
+	}
+	if err := s.store.Commit(mutation); err != nil {
+		return fmt.Errorf("persist seat change: %w", err)
+	}
+
+	for i, assignment := range hold.Seats {
+		s.Sections[assignment.Section].Occupancy[assignment.Seat] = updates[i]
+	}
+	delete(s.holds, id)
+	kind := pb.SeatEventKind_SEAT_EVENT_KIND_HOLD_RELEASED
+	if state == "Assigned" {
+		kind = pb.SeatEventKind_SEAT_EVENT_KIND_HOLD_CONFIRMED
+	}
+	s.publish(kind, hold.From, hold.To, hold.Seats)
+	return nil
+}
+
+// ReleaseSeat releases a seat assigned from one stop to another, making it
+// available again on those segments only.
+func (s *SeatManager) ReleaseSeat(seat int, seatSection string, from, to string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -86,17 +587,31 @@ func (s *SeatManager) ReleaseSeat(seat int, seatSection string) error {
 		return fmt.Errorf("Section not found")
 	}
 
-	if section.AvailableSeats[seat] == "Assigned" {
-		section.AvailableSeats[seat] = "Available"
+	start, end, err := s.segmentRange(from, to)
+	if err != nil {
+		return err
+	}
+
+	segments := section.Occupancy[seat]
+	if segments != nil && segmentsIn(segments, start, end, "Assigned") {
+		updated := withSegments(segments, start, end, "Available")
+		if err := s.commitSeats(seatSection, map[int][]string{seat: updated}); err != nil {
+			return err
+		}
+		section.Occupancy[seat] = updated
+		s.publish(pb.SeatEventKind_SEAT_EVENT_KIND_RELEASED, from, to, []SeatAssignment{{Seat: seat, Section: seatSection}})
 		return nil
 	}
 
 	return fmt.Errorf("seat is not assigned yet")
 }
 
-// ModifySeat changes the seat assignment from one seat to another.
-func (s *SeatManager) ModifySeat(seat int, seatSection string, newSeat int, newSection string) error {
-	// Validate inputs before locking
+// ModifySeat changes the seat assignment from one seat to another for a
+// journey from one stop to another.
+func (s *SeatManager) ModifySeat(seat int, seatSection string, newSeat int, newSection string, from, to string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	oldSection, ok := s.Sections[seatSection]
 	if !ok {
 		return fmt.Errorf("old section not found")
@@ -106,22 +621,76 @@ func (s *SeatManager) ModifySeat(seat int, seatSection string, newSeat int, newS
 	if !ok {
 		return fmt.Errorf("new section not found")
 	}
+	if nwSection.Closed && newSection != seatSection {
+		return fmt.Errorf("new section is closed")
+	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	start, end, err := s.segmentRange(from, to)
+	if err != nil {
+		return err
+	}
 
 	// Check seat assignment
-	if oldSection.AvailableSeats[seat] != "Assigned" {
+	oldSegments := oldSection.Occupancy[seat]
+	if oldSegments == nil || !segmentsIn(oldSegments, start, end, "Assigned") {
 		return fmt.Errorf("old seat is not assigned")
 	}
 
-	if nwSection.AvailableSeats[newSeat] != "Available" {
+	releasedSegments := withSegments(oldSegments, start, end, "Available")
+	newSegments := nwSection.Occupancy[newSeat]
+	if newSegments == nil || !segmentsIn(newSegments, start, end, "Available") {
 		return fmt.Errorf("new seat is not available")
 	}
+	assignedSegments := withSegments(newSegments, start, end, "Assigned")
+
+	oldKey := departureStoreKey(s.departureID, seatSection)
+	newKey := departureStoreKey(s.departureID, newSection)
+	mutation := &Mutation{Seats: map[string]map[int][]string{}}
+	mutation.Seats[oldKey] = map[int][]string{seat: releasedSegments}
+	if mutation.Seats[newKey] == nil {
+		mutation.Seats[newKey] = map[int][]string{}
+	}
+	mutation.Seats[newKey][newSeat] = assignedSegments
+	if err := s.store.Commit(mutation); err != nil {
+		return fmt.Errorf("persist seat change: %w", err)
+	}
 
 	// Swap seat assignments
-	oldSection.AvailableSeats[seat] = "Available"
-	nwSection.AvailableSeats[newSeat] = "Assigned"
+	oldSection.Occupancy[seat] = releasedSegments
+	nwSection.Occupancy[newSeat] = assignedSegments
+	s.publish(pb.SeatEventKind_SEAT_EVENT_KIND_MODIFIED, from, to, []SeatAssignment{{Seat: seat, Section: seatSection}, {Seat: newSeat, Section: newSection}})
 
 	return nil
 }
+
+// commitSeats persists new seat occupancy for a section. Callers must hold s.mu.
+func (s *SeatManager) commitSeats(section string, seats map[int][]string) error {
+	key := departureStoreKey(s.departureID, section)
+	if err := s.store.Commit(&Mutation{Seats: map[string]map[int][]string{key: seats}}); err != nil {
+		return fmt.Errorf("persist seat change: %w", err)
+	}
+	return nil
+}
+
+// restoreSeats overwrites seat occupancy with previously persisted values, keyed
+// as by departureStoreKey. Entries for other departures, unknown sections, seats
+// outside a section or a different number of segments are ignored. A seat saved
+// with a single state, as before seats were tracked per segment, has that state
+// on every segment.
+func (s *SeatManager) restoreSeats(seats map[string]map[int][]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, section := range s.Sections {
+		for seat, segments := range seats[departureStoreKey(s.departureID, name)] {
+			current, ok := section.Occupancy[seat]
+			switch {
+			case !ok:
+			case len(current) == len(segments):
+				section.Occupancy[seat] = append([]string(nil), segments...)
+			case len(segments) == 1:
+				section.Occupancy[seat] = withSegments(current, 0, len(current), segments[0])
+			}
+		}
+	}
+}
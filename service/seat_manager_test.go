@@ -3,6 +3,7 @@ package service
 import (
     "fmt"
     "testing"
+    "time"
 
     "github.com/stretchr/testify/assert"
 )
@@ -13,14 +14,14 @@ func TestNewSeatManager(t *testing.T) {
         {SectionName: "A", MaxSeats: 50},
         {SectionName: "B", MaxSeats: 60},
     }
-    seatManager := NewSeatManager(sectionConfigs)
+    seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
 
     assert.NotNil(t, seatManager, "SeatManager should be initialized")
     assert.Equal(t, 2, len(seatManager.Sections), "Should have 2 sections")
     assert.Contains(t, seatManager.Sections, "A", "Section A should exist")
     assert.Contains(t, seatManager.Sections, "B", "Section B should exist")
-    assert.Equal(t, 50, len(seatManager.Sections["A"].AvailableSeats), "Section A should have 50 seats")
-    assert.Equal(t, 60, len(seatManager.Sections["B"].AvailableSeats), "Section B should have 60 seats")
+    assert.Equal(t, 50, len(seatManager.Sections["A"].Occupancy), "Section A should have 50 seats")
+    assert.Equal(t, 60, len(seatManager.Sections["B"].Occupancy), "Section B should have 60 seats")
 }
 
 // Table-driven test for AssignSeat
@@ -44,8 +45,8 @@ func TestAssignSeat(t *testing.T) {
         {
             name: "All seats occupied",
             setup: func(sm *SeatManager) {
-                _, _, _ = sm.AssignSeat() // Assign all seats
-                _, _, _ = sm.AssignSeat()
+                _, _, _ = sm.AssignSeat("London", "France") // Assign all seats
+                _, _, _ = sm.AssignSeat("London", "France")
             },
             expectErr: true,
         },
@@ -53,10 +54,10 @@ func TestAssignSeat(t *testing.T) {
 
     for _, tt := range tests {
         t.Run(tt.name, func(t *testing.T) {
-            seatManager := NewSeatManager(sectionConfigs)
+            seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
             tt.setup(seatManager)
 
-            seat, section, err := seatManager.AssignSeat()
+            seat, section, err := seatManager.AssignSeat("London", "France")
 
             if tt.expectErr {
                 assert.Error(t, err, "Expected an error but got none")
@@ -76,17 +77,17 @@ func TestReleaseSeat(t *testing.T) {
         {SectionName: "A", MaxSeats: 1},
         {SectionName: "B", MaxSeats: 1},
     }
-    seatManager := NewSeatManager(sectionConfigs)
-    seat, section, _ := seatManager.AssignSeat()
+    seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+    seat, section, _ := seatManager.AssignSeat("London", "France")
 
     t.Run("Successfully release a seat", func(t *testing.T) {
-        err := seatManager.ReleaseSeat(seat, section)
+        err := seatManager.ReleaseSeat(seat, section, "London", "France")
         assert.NoError(t, err, "Releasing an assigned seat should not return an error")
-        assert.Equal(t, "Available", seatManager.Sections[section].AvailableSeats[seat], "Seat should be available after release")
+        assert.Equal(t, "Available", seatManager.Sections[section].SeatState(seat), "Seat should be available after release")
     })
 
     t.Run("Releasing unassigned seat should fail", func(t *testing.T) {
-        err := seatManager.ReleaseSeat(seat, section)
+        err := seatManager.ReleaseSeat(seat, section, "London", "France")
         assert.Error(t, err, "Expected an error when releasing an already available seat")
     })
 }
@@ -97,29 +98,166 @@ func TestModifySeat(t *testing.T) {
         {SectionName: "A", MaxSeats: 2}, // Increased MaxSeats to allow modification
         {SectionName: "B", MaxSeats: 1},
     }
-    seatManager := NewSeatManager(sectionConfigs)
-    seat, section, _ := seatManager.AssignSeat()
+    seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+    seat, section, _ := seatManager.AssignSeat("London", "France")
     newSeat := 2 // We assume seat 2 is available
 
     // Make seat 2 available
-    seatManager.Sections["A"].AvailableSeats[newSeat] = "Available"
+    seatManager.Sections["A"].Occupancy[newSeat] = []string{"Available"}
 
     t.Run("Modify seat successfully", func(t *testing.T) {
-        err := seatManager.ModifySeat(seat, section, newSeat, section)
+        err := seatManager.ModifySeat(seat, section, newSeat, section, "London", "France")
         assert.NoError(t, err, "Modifying seat should not return an error")
-        assert.Equal(t, "Available", seatManager.Sections[section].AvailableSeats[seat], "Old seat should be available after modification")
-        assert.Equal(t, "Assigned", seatManager.Sections[section].AvailableSeats[newSeat], "New seat should be assigned")
+        assert.Equal(t, "Available", seatManager.Sections[section].SeatState(seat), "Old seat should be available after modification")
+        assert.Equal(t, "Assigned", seatManager.Sections[section].SeatState(newSeat), "New seat should be assigned")
     })
 
     t.Run("Modify to an occupied seat should fail", func(t *testing.T) {
         //Reassign seat 2
-        seatManager.Sections["A"].AvailableSeats[newSeat] = "Assigned"
-        err := seatManager.ModifySeat(seat, section, newSeat, section)
+        seatManager.Sections["A"].Occupancy[newSeat] = []string{"Assigned"}
+        err := seatManager.ModifySeat(seat, section, newSeat, section, "London", "France")
         assert.Error(t, err, "Expected error when modifying to an already assigned seat")
     })
 
     t.Run("Modify seat in non-existent section should fail", func(t *testing.T) {
-        err := seatManager.ModifySeat(seat, section, 10, "C") // Section C does not exist
+        err := seatManager.ModifySeat(seat, section, 10, "C", "London", "France") // Section C does not exist
         assert.Error(t, err, "Expected error when modifying to a non-existent section")
     })
-}
\ No newline at end of file
+}
+
+// Test segment-aware assignment on a multi-stop route
+func TestSegmentOccupancy(t *testing.T) {
+    sectionConfigs := []SectionConfigs{
+        {SectionName: "A", MaxSeats: 1},
+    }
+    stops := []string{"London", "Paris", "Brussels", "Amsterdam"}
+    seatManager := NewRouteSeatManager(sectionConfigs, stops, NewMemoryStore())
+
+    seat, section, err := seatManager.AssignSeat("London", "Paris")
+    assert.NoError(t, err)
+    assert.Equal(t, []string{"Assigned", "Available", "Available"}, seatManager.Sections[section].Occupancy[seat])
+
+    t.Run("Overlapping range is refused", func(t *testing.T) {
+        _, _, err := seatManager.AssignSeat("London", "Brussels")
+        assert.Error(t, err, "Seat is taken on London-Paris")
+    })
+
+    t.Run("Disjoint range reuses the seat", func(t *testing.T) {
+        nextSeat, _, err := seatManager.AssignSeat("Paris", "Amsterdam")
+        assert.NoError(t, err)
+        assert.Equal(t, seat, nextSeat)
+        assert.Equal(t, []string{"Assigned", "Assigned", "Assigned"}, seatManager.Sections[section].Occupancy[seat])
+    })
+
+    t.Run("Release frees only its range", func(t *testing.T) {
+        err := seatManager.ReleaseSeat(seat, section, "Paris", "Amsterdam")
+        assert.NoError(t, err)
+        assert.Equal(t, []string{"Assigned", "Available", "Available"}, seatManager.Sections[section].Occupancy[seat])
+    })
+
+    t.Run("Stations off the route or reversed are rejected", func(t *testing.T) {
+        _, _, err := seatManager.AssignSeat("London", "Berlin")
+        assert.Error(t, err)
+        _, _, err = seatManager.AssignSeat("Brussels", "Paris")
+        assert.Error(t, err)
+    })
+}
+
+// Test that a group is seated together or not at all
+func TestAssignSeats(t *testing.T) {
+    sectionConfigs := []SectionConfigs{
+        {SectionName: "A", MaxSeats: 4},
+        {SectionName: "B", MaxSeats: 2},
+    }
+
+    t.Run("Prefers consecutive seats", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+        seatManager.Sections["A"].Occupancy[2] = []string{"Assigned"}
+
+        seats, err := seatManager.AssignSeats("London", "France", 2)
+        assert.NoError(t, err)
+        assert.Equal(t, []SeatAssignment{{Seat: 3, Section: "A"}, {Seat: 4, Section: "A"}}, seats)
+    })
+
+    t.Run("Falls back to other sections before splitting", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+        seatManager.Sections["A"].Occupancy[2] = []string{"Assigned"}
+        seatManager.Sections["A"].Occupancy[4] = []string{"Assigned"}
+
+        seats, err := seatManager.AssignSeats("London", "France", 2)
+        assert.NoError(t, err)
+        assert.Equal(t, []SeatAssignment{{Seat: 1, Section: "B"}, {Seat: 2, Section: "B"}}, seats)
+
+        seats, err = seatManager.AssignSeats("London", "France", 2)
+        assert.NoError(t, err)
+        assert.Equal(t, []SeatAssignment{{Seat: 1, Section: "A"}, {Seat: 3, Section: "A"}}, seats)
+    })
+
+    t.Run("Splits across sections when needed", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+
+        seats, err := seatManager.AssignSeats("London", "France", 5)
+        assert.NoError(t, err)
+        assert.Len(t, seats, 5)
+        for _, seat := range seats {
+            assert.Equal(t, "Assigned", seatManager.Sections[seat.Section].SeatState(seat.Seat))
+        }
+    })
+
+    t.Run("Assigns nothing when the group does not fit", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+
+        _, err := seatManager.AssignSeats("London", "France", 7)
+        assert.Error(t, err)
+        for _, section := range seatManager.Sections {
+            for seat := 1; seat <= section.MaxSeats; seat++ {
+                assert.Equal(t, "Available", section.SeatState(seat))
+            }
+        }
+    })
+}
+
+// Test holding, confirming and expiring seats
+func TestHoldSeats(t *testing.T) {
+    sectionConfigs := []SectionConfigs{
+        {SectionName: "A", MaxSeats: 2},
+    }
+    expiresAt := time.Now().Add(time.Minute)
+
+    t.Run("Held seats are not assigned to others", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+
+        seats, err := seatManager.HoldSeats("h1", "London", "France", 2, SeatRequest{}, expiresAt)
+        assert.NoError(t, err)
+        assert.Len(t, seats, 2)
+        assert.Equal(t, "Held", seatManager.Sections["A"].SeatState(1))
+
+        _, _, err = seatManager.AssignSeat("London", "France")
+        assert.Error(t, err, "Expected no seats while both are held")
+    })
+
+    t.Run("Confirming assigns the seats", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+
+        seats, err := seatManager.HoldSeats("h1", "London", "France", 1, SeatRequest{}, expiresAt)
+        assert.NoError(t, err)
+        assert.NoError(t, seatManager.ConfirmHold("h1"))
+        assert.Equal(t, "Assigned", seatManager.Sections["A"].SeatState(seats[0].Seat))
+        assert.Error(t, seatManager.ReleaseHold("h1"), "Expected the hold to be gone")
+    })
+
+    t.Run("Expired holds are released", func(t *testing.T) {
+        seatManager := NewSeatManager(sectionConfigs, NewMemoryStore())
+
+        _, err := seatManager.HoldSeats("soon", "London", "France", 1, SeatRequest{}, expiresAt)
+        assert.NoError(t, err)
+        _, err = seatManager.HoldSeats("later", "London", "France", 1, SeatRequest{}, expiresAt.Add(time.Hour))
+        assert.NoError(t, err)
+
+        released, err := seatManager.ReleaseExpiredHolds(expiresAt)
+        assert.NoError(t, err)
+        assert.Equal(t, []string{"soon"}, released)
+        assert.Equal(t, "Available", seatManager.Sections["A"].SeatState(1))
+        assert.Equal(t, "Held", seatManager.Sections["A"].SeatState(2))
+    })
+}
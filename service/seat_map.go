@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// seatMapSymbols are the characters RenderSeatMap draws for each cell state.
+var seatMapSymbols = map[string]string{
+	"Available":   ".",
+	"Held":        "h",
+	"Assigned":    "x",
+	"Blocked":     "-",
+	"Unavailable": "#",
+	"":            " ",
+}
+
+// GetSeatMap returns the seat grid of a departure's sections with the state of
+// each seat, for the whole route or for one journey.
+func (t *TicketManager) GetSeatMap(ctx context.Context, req *pb.GetSeatMapRequest) (*pb.SeatMap, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("GetSeatMap request received: %+v", req)
+
+	if (req.From == "") != (req.To == "") {
+		log.Printf("GetSeatMap request with partial journey: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "from and to must be given together")
+	}
+
+	departure, ok := t.departure(req.DepartureId)
+	if !ok {
+		log.Printf("GetSeatMap request with unknown departure: %s", req.DepartureId)
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+
+	sections, err := departure.SeatManager.seatMap(req.Section, req.From, req.To)
+	if err != nil {
+		log.Printf("GetSeatMap failed: %v", err)
+		return nil, err
+	}
+
+	return &pb.SeatMap{DepartureId: departure.ID, From: req.From, To: req.To, Sections: sections}, nil
+}
+
+// seatMap lays out the seats of one section, or of every section in
+// configuration order when section is empty. Without a journey a seat shows
+// its state over the whole route.
+func (s *SeatManager) seatMap(section, from, to string) ([]*pb.CoachMap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start, end := 0, 0
+	if from != "" {
+		var err error
+		if start, end, err = s.segmentRange(from, to); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	names := s.nextSections
+	if section != "" {
+		if _, ok := s.Sections[section]; !ok {
+			return nil, status.Error(codes.NotFound, "section not found")
+		}
+		names = []string{section}
+	}
+
+	coaches := []*pb.CoachMap{}
+	for _, name := range names {
+		sec := s.Sections[name]
+		layout := defaultLayout(sec.MaxSeats)
+		if sec.Layout != nil {
+			layout = *sec.Layout
+		}
+
+		coach := &pb.CoachMap{Name: name, Columns: layout.Columns, FareClass: sec.FareClass}
+		for i, cells := range layout.grid() {
+			row := &pb.SeatRow{Number: int32(i + 1)}
+			for _, position := range cells {
+				cell := &pb.SeatCell{Label: position.Label, SeatNumber: int32(position.Seat)}
+				switch {
+				case position.Seat > 0 && from == "":
+					cell.State = sec.SeatState(position.Seat)
+				case position.Seat > 0:
+					cell.State = rangeState(sec.Occupancy[position.Seat], start, end)
+				case position.Label != "":
+					cell.State = "Unavailable"
+				}
+				if position.Seat > 0 {
+					cell.Features = seatFeaturesToProto(sec.SeatFeatures(position.Seat))
+				}
+				row.Cells = append(row.Cells, cell)
+			}
+			coach.Rows = append(coach.Rows, row)
+		}
+		coaches = append(coaches, coach)
+	}
+	return coaches, nil
+}
+
+// rangeState summarizes a seat over the segments [start, end) as SeatState
+// does over the whole route.
+func rangeState(segments []string, start, end int) string {
+	summary := "Available"
+	for i := start; i < end && i < len(segments); i++ {
+		switch {
+		case segments[i] == "Assigned":
+			return "Assigned"
+		case segments[i] == "Held":
+			summary = "Held"
+		case segments[i] == "Blocked" && summary == "Available":
+			summary = "Blocked"
+		}
+	}
+	return summary
+}
+
+// RenderSeatMap draws a seat map as text for a terminal, one block per section:
+//
+//	Section A
+//	     A B   C D
+//	 1   . x   . h
+//	 2   . .   # #
+//
+// where "." is available, "h" held, "x" assigned, "-" blocked and "#" a position
+// without a seat.
+func RenderSeatMap(seatMap *pb.SeatMap) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Departure %s", seatMap.GetDepartureId())
+	if seatMap.GetFrom() != "" {
+		fmt.Fprintf(&b, " (%s-%s)", seatMap.GetFrom(), seatMap.GetTo())
+	}
+	b.WriteString("\n")
+
+	for _, coach := range seatMap.GetSections() {
+		rowWidth := len(strconv.Itoa(len(coach.Rows)))
+		width := 1
+		for _, column := range coach.Columns {
+			width = max(width, len(column))
+		}
+
+		fmt.Fprintf(&b, "\nSection %s\n", coach.Name)
+		b.WriteString(strings.Repeat(" ", rowWidth+3))
+		for _, column := range coach.Columns {
+			fmt.Fprintf(&b, " %-*s", width, column)
+		}
+		b.WriteString("\n")
+
+		for _, row := range coach.Rows {
+			fmt.Fprintf(&b, "%*d  ", rowWidth+1, row.Number)
+			for _, cell := range row.Cells {
+				fmt.Fprintf(&b, " %-*s", width, seatMapSymbols[cell.State])
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("\n. available  h held  x assigned  # no seat\n")
+
+	// Padding leaves spaces at the end of rows that end in a gap.
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
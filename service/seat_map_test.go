@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetSeatMap(t *testing.T) {
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", Layout: &testLayout}}, store), map[string]int64{
+		"London-Paris": 5000, "Paris-Brussels": 2000, "London-Brussels": 6000,
+	}, store)
+	_, err := tm.AddDeparture(DepartureConfig{
+		ID: "LPB", Stops: []string{"London", "Paris", "Brussels"},
+		Sections: []SectionConfigs{{SectionName: "A", Layout: &testLayout}, {SectionName: "B", MaxSeats: 3}},
+	})
+	require.NoError(t, err)
+	fill, err := NewAllocationStrategy(FillSectionFirst)
+	require.NoError(t, err)
+	tm.SetAllocationStrategy("", fill)
+
+	_, err = tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "map@example.com"}, From: "London", To: "Paris", DepartureId: "LPB",
+	})
+	require.NoError(t, err)
+	_, err = tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "Brussels", DepartureId: "LPB"})
+	require.NoError(t, err)
+
+	t.Run("Whole route", func(t *testing.T) {
+		seatMap, err := tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "LPB"})
+		require.NoError(t, err)
+		require.Len(t, seatMap.Sections, 2)
+
+		coach := seatMap.Sections[0]
+		assert.Equal(t, "A", coach.Name)
+		assert.Equal(t, testLayout.Columns, coach.Columns)
+		require.Len(t, coach.Rows, 3)
+
+		first := coach.Rows[0].Cells
+		assert.Equal(t, "1A", first[0].Label)
+		assert.Equal(t, "Assigned", first[0].State)
+		assert.Equal(t, []pb.SeatFeature{pb.SeatFeature_SEAT_FEATURE_WINDOW}, first[0].Features)
+		assert.Equal(t, "", first[2].State, "Aisle gap")
+		assert.Equal(t, "Unavailable", first[4].State, "Door at 1D")
+		assert.Equal(t, "B", seatMap.Sections[1].Name)
+
+		assert.Equal(t, `Departure LPB
+
+Section A
+     A B   C D
+ 1   x h   . #
+ 2   . .   . .
+ 3   . .   . .
+
+Section B
+     A B   C D
+ 1   . .   . #
+
+. available  h held  x assigned  # no seat
+`, RenderSeatMap(seatMap))
+	})
+
+	t.Run("One journey", func(t *testing.T) {
+		seatMap, err := tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "LPB", Section: "A", From: "Paris", To: "Brussels"})
+		require.NoError(t, err)
+		require.Len(t, seatMap.Sections, 1)
+
+		first := seatMap.Sections[0].Rows[0].Cells
+		assert.Equal(t, "Available", first[0].State, "Seat 1A is only sold London-Paris")
+		assert.Equal(t, "Held", first[1].State)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "nope"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "LPB", Section: "Z"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "LPB", From: "Paris"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = tm.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{DepartureId: "LPB", From: "Brussels", To: "London"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,441 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Store persists receipts and seat inventory so that bookings survive a restart.
+type Store interface {
+	// Load returns the booking state persisted so far.
+	Load() (*BookingState, error)
+	// Commit durably applies a mutation before returning.
+	Commit(mutation *Mutation) error
+}
+
+// BookingState is the persisted view of receipts (keyed by booking reference), the
+// per-segment seat occupancy of every section, refunds (keyed by refund ID),
+// promo codes (keyed by normalized code) and sections changed with
+// AdminService (keyed as seats are).
+type BookingState struct {
+	Receipts   map[string]*pb.TicketReceipt
+	Seats      map[string]map[int][]string
+	Refunds    map[string]*pb.RefundRecord
+	PromoCodes map[string]*pb.PromoCode
+	Sections   map[string]*SectionState
+}
+
+// Mutation is a set of changes committed to a Store as a single unit.
+// A nil receipt, refund, promo code or section removes the one stored under
+// that key.
+type Mutation struct {
+	Receipts   map[string]*pb.TicketReceipt
+	Seats      map[string]map[int][]string
+	Refunds    map[string]*pb.RefundRecord
+	PromoCodes map[string]*pb.PromoCode
+	Sections   map[string]*SectionState
+}
+
+// SectionState is a section as changed with AdminService. A section that is
+// not administered is defined by the config, and only Closed is restored.
+type SectionState struct {
+	MaxSeats  int          `json:"max_seats,omitempty"`
+	Layout    *CoachLayout `json:"layout,omitempty"`
+	FareClass string       `json:"fare_class,omitempty"`
+	// Features apply to every seat, including seats added by a resize, and
+	// SeatFeatures lists the features of each seat that has any.
+	Features     []SeatFeature         `json:"features,omitempty"`
+	SeatFeatures map[int][]SeatFeature `json:"seat_features,omitempty"`
+	Closed       bool                  `json:"closed,omitempty"`
+	Administered bool                  `json:"administered,omitempty"`
+}
+
+// clone returns a deep copy of the section state.
+func (s *SectionState) clone() *SectionState {
+	clone := *s
+	if s.Layout != nil {
+		layout := *s.Layout
+		layout.Columns = slices.Clone(s.Layout.Columns)
+		layout.Unavailable = slices.Clone(s.Layout.Unavailable)
+		clone.Layout = &layout
+	}
+	clone.Features = slices.Clone(s.Features)
+	clone.SeatFeatures = make(map[int][]SeatFeature, len(s.SeatFeatures))
+	for seat, features := range s.SeatFeatures {
+		clone.SeatFeatures[seat] = slices.Clone(features)
+	}
+	return &clone
+}
+
+// NewBookingState returns an empty BookingState.
+func NewBookingState() *BookingState {
+	return &BookingState{
+		Receipts:   make(map[string]*pb.TicketReceipt),
+		Seats:      make(map[string]map[int][]string),
+		Refunds:    make(map[string]*pb.RefundRecord),
+		PromoCodes: make(map[string]*pb.PromoCode),
+		Sections:   make(map[string]*SectionState),
+	}
+}
+
+// Apply applies a mutation to the state.
+func (b *BookingState) Apply(mutation *Mutation) {
+	for key, receipt := range mutation.Receipts {
+		if receipt == nil {
+			delete(b.Receipts, key)
+			continue
+		}
+		b.Receipts[key] = proto.Clone(receipt).(*pb.TicketReceipt)
+	}
+	for section, seats := range mutation.Seats {
+		if b.Seats[section] == nil {
+			b.Seats[section] = make(map[int][]string)
+		}
+		for seat, segments := range seats {
+			b.Seats[section][seat] = append([]string(nil), segments...)
+		}
+	}
+	for id, refund := range mutation.Refunds {
+		if refund == nil {
+			delete(b.Refunds, id)
+			continue
+		}
+		b.Refunds[id] = proto.Clone(refund).(*pb.RefundRecord)
+	}
+	for code, promoCode := range mutation.PromoCodes {
+		if promoCode == nil {
+			delete(b.PromoCodes, code)
+			continue
+		}
+		b.PromoCodes[code] = proto.Clone(promoCode).(*pb.PromoCode)
+	}
+	for key, section := range mutation.Sections {
+		if section == nil {
+			delete(b.Sections, key)
+			continue
+		}
+		b.Sections[key] = section.clone()
+	}
+}
+
+// Clone returns a deep copy of the state.
+func (b *BookingState) Clone() *BookingState {
+	clone := NewBookingState()
+	clone.Apply(&Mutation{Receipts: b.Receipts, Seats: b.Seats, Refunds: b.Refunds, PromoCodes: b.PromoCodes, Sections: b.Sections})
+	return clone
+}
+
+// MemoryStore keeps booking state in process memory only. It is the default
+// store and loses everything when the process exits.
+type MemoryStore struct {
+	mu    sync.Mutex
+	state *BookingState
+}
+
+// NewMemoryStore initializes an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{state: NewBookingState()}
+}
+
+// Load returns a copy of the in-memory state.
+func (m *MemoryStore) Load() (*BookingState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.state.Clone(), nil
+}
+
+// Commit applies the mutation to the in-memory state.
+func (m *MemoryStore) Commit(mutation *Mutation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.state.Apply(mutation)
+	return nil
+}
+
+// FileStore persists the whole booking state as a JSON document. Every commit
+// rewrites the file atomically, so a crash leaves either the old or the new
+// state on disk and never a partial one.
+type FileStore struct {
+	mu    sync.Mutex
+	path  string
+	state *BookingState
+}
+
+// fileState is the on-disk encoding of a BookingState.
+type fileState struct {
+	Seq        uint64                     `json:"seq,omitempty"`
+	Receipts   map[string]json.RawMessage `json:"receipts"`
+	Seats      storedSeats                `json:"seats"`
+	Refunds    map[string]json.RawMessage `json:"refunds,omitempty"`
+	PromoCodes map[string]json.RawMessage `json:"promo_codes,omitempty"`
+	Sections   map[string]*SectionState   `json:"sections,omitempty"`
+}
+
+// storedSeats is the on-disk encoding of per-segment seat states. Files written
+// before seats were tracked per segment hold a single state per seat, such as
+// {"A": {"1": "Assigned"}}; it is read as a seat with one segment, which
+// restoreSeats spreads over every segment of the seat.
+type storedSeats map[string]map[int][]string
+
+// UnmarshalJSON decodes both the per-segment and the single-state form.
+func (s *storedSeats) UnmarshalJSON(data []byte) error {
+	var raw map[string]map[int]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	seats := make(storedSeats, len(raw))
+	for section, sectionSeats := range raw {
+		seats[section] = make(map[int][]string, len(sectionSeats))
+		for seat, encoded := range sectionSeats {
+			var segments []string
+			if err := json.Unmarshal(encoded, &segments); err != nil {
+				var state string
+				if json.Unmarshal(encoded, &state) != nil {
+					return fmt.Errorf("seat %s/%d: %w", section, seat, err)
+				}
+				segments = []string{state}
+			}
+			seats[section][seat] = segments
+		}
+	}
+	*s = seats
+	return nil
+}
+
+// NewFileStore opens the store at path, reading any state already saved there.
+func NewFileStore(path string) (*FileStore, error) {
+	state, _, err := readStateFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileStore{path: path, state: state}, nil
+}
+
+// Load returns a copy of the persisted state.
+func (f *FileStore) Load() (*BookingState, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.state.Clone(), nil
+}
+
+// Commit applies the mutation and rewrites the state file.
+func (f *FileStore) Commit(mutation *Mutation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	next := f.state.Clone()
+	next.Apply(mutation)
+	if err := writeStateFile(f.path, next, 0); err != nil {
+		return err
+	}
+
+	f.state = next
+	return nil
+}
+
+// readStateFile decodes a state file and the log sequence number it was written
+// at, returning an empty state if the file does not exist.
+func readStateFile(path string) (*BookingState, uint64, error) {
+	state := NewBookingState()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, 0, nil
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("read state file: %w", err)
+	}
+
+	var decoded fileState
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, 0, fmt.Errorf("decode state file: %w", err)
+	}
+
+	receipts, err := decodeMessages("receipt", decoded.Receipts, func() *pb.TicketReceipt { return &pb.TicketReceipt{} })
+	if err != nil {
+		return nil, 0, err
+	}
+	refunds, err := decodeMessages("refund", decoded.Refunds, func() *pb.RefundRecord { return &pb.RefundRecord{} })
+	if err != nil {
+		return nil, 0, err
+	}
+	promoCodes, err := decodeMessages("promo code", decoded.PromoCodes, func() *pb.PromoCode { return &pb.PromoCode{} })
+	if err != nil {
+		return nil, 0, err
+	}
+	state.Apply(&Mutation{Receipts: receipts, Seats: decoded.Seats, Refunds: refunds, PromoCodes: promoCodes, Sections: decoded.Sections})
+
+	return state, decoded.Seq, nil
+}
+
+// writeStateFile encodes the state to a temporary file, syncs it and renames it over path.
+func writeStateFile(path string, state *BookingState, seq uint64) error {
+	receipts, err := encodeMessages("receipt", state.Receipts)
+	if err != nil {
+		return err
+	}
+	refunds, err := encodeMessages("refund", state.Refunds)
+	if err != nil {
+		return err
+	}
+	promoCodes, err := encodeMessages("promo code", state.PromoCodes)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(fileState{Seq: seq, Receipts: receipts, Seats: state.Seats, Refunds: refunds, PromoCodes: promoCodes, Sections: state.Sections})
+	if err != nil {
+		return fmt.Errorf("encode state file: %w", err)
+	}
+
+	return writeFileSync(path, data)
+}
+
+// encodeMessages encodes messages, such as receipts, with protojson. A nil
+// message is encoded as JSON null.
+func encodeMessages[M proto.Message](kind string, messages map[string]M) (map[string]json.RawMessage, error) {
+	encoded := make(map[string]json.RawMessage, len(messages))
+	for key, message := range messages {
+		if !message.ProtoReflect().IsValid() {
+			encoded[key] = json.RawMessage("null")
+			continue
+		}
+		raw, err := protojson.Marshal(message)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s %q: %w", kind, key, err)
+		}
+		encoded[key] = raw
+	}
+	return encoded, nil
+}
+
+// decodeMessages reverses encodeMessages, decoding into messages made by
+// newMessage.
+func decodeMessages[M proto.Message](kind string, encoded map[string]json.RawMessage, newMessage func() M) (map[string]M, error) {
+	messages := make(map[string]M, len(encoded))
+	for key, raw := range encoded {
+		if string(raw) == "null" {
+			var none M
+			messages[key] = none
+			continue
+		}
+		raw, err := upgradeLegacyPrices(raw)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s %q: %w", kind, key, err)
+		}
+		message := newMessage()
+		if err := protojson.Unmarshal(raw, message); err != nil {
+			return nil, fmt.Errorf("decode %s %q: %w", kind, key, err)
+		}
+		messages[key] = message
+	}
+	return messages, nil
+}
+
+// legacyPriceFields are the JSON names of the fields that were prices in
+// major units of DefaultCurrency before they became Money.
+var legacyPriceFields = map[string]bool{
+	"price": true, "fareDifference": true, "originalPrice": true, "discount": true,
+	"amount": true, "basePrice": true, "amountOff": true,
+	"fare_difference": true, "original_price": true, "base_price": true, "amount_off": true,
+}
+
+// upgradeLegacyPrices rewrites the plain-number prices of a message stored
+// before prices became Money, such as "price":20, as Money in minor units of
+// DefaultCurrency, such as {"currencyCode":"GBP","minorUnits":"2000"}.
+// Messages without such prices are returned unchanged.
+func upgradeLegacyPrices(raw json.RawMessage) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if !upgradePrices(value) {
+		return raw, nil
+	}
+	return json.Marshal(value)
+}
+
+// upgradePrices replaces legacy prices in a decoded JSON value in place and
+// reports whether it found any.
+func upgradePrices(value any) bool {
+	upgraded := false
+	switch value := value.(type) {
+	case map[string]any:
+		for name, field := range value {
+			if number, ok := field.(json.Number); ok && legacyPriceFields[name] {
+				major, ok := new(big.Rat).SetString(number.String())
+				if !ok {
+					continue
+				}
+				minor := RoundHalfUp.round(major.Mul(major, new(big.Rat).SetInt(pow10(CurrencyExponent(DefaultCurrency)))))
+				value[name] = map[string]any{"currencyCode": DefaultCurrency, "minorUnits": strconv.FormatInt(minor, 10)}
+				upgraded = true
+				continue
+			}
+			upgraded = upgradePrices(field) || upgraded
+		}
+	case []any:
+		for _, item := range value {
+			upgraded = upgradePrices(item) || upgraded
+		}
+	}
+	return upgraded
+}
+
+// writeFileSync atomically replaces path with data and syncs the parent directory.
+func writeFileSync(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("replace file: %w", err)
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+// syncDir flushes directory metadata so that a rename survives a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("open directory: %w", err)
+	}
+	defer d.Close()
+
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("sync directory: %w", err)
+	}
+	return nil
+}
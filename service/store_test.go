@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStoreCommit(t *testing.T) {
+	store := NewMemoryStore()
+
+	receipt := &pb.TicketReceipt{From: "London", To: "France", Seat: &pb.Seat{SeatNumber: 1, Section: "A"}}
+	err := store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{"a@example.com": receipt},
+		Seats:    map[string]map[int][]string{"A": {1: {"Assigned"}}},
+	})
+	require.NoError(t, err)
+
+	state, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "London", state.Receipts["a@example.com"].From)
+	assert.Equal(t, []string{"Assigned"}, state.Seats["A"][1])
+
+	// The loaded state is a copy and must not alias the store.
+	state.Receipts["a@example.com"].From = "Paris"
+	state, _ = store.Load()
+	assert.Equal(t, "London", state.Receipts["a@example.com"].From)
+
+	require.NoError(t, store.Commit(&Mutation{Receipts: map[string]*pb.TicketReceipt{"a@example.com": nil}}))
+	state, _ = store.Load()
+	assert.NotContains(t, state.Receipts, "a@example.com", "nil receipt should delete the key")
+}
+
+func TestFileStoreSurvivesReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.json")
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+
+	err = store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{
+			"a@example.com": {User: &pb.User{Email: "a@example.com"}, Price: &pb.Money{CurrencyCode: "GBP", MinorUnits: 2000}, Seat: &pb.Seat{SeatNumber: 3, Section: "B"}},
+		},
+		Seats: map[string]map[int][]string{"B": {3: {"Assigned"}}},
+	})
+	require.NoError(t, err)
+
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+
+	state, err := reopened.Load()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), state.Receipts["a@example.com"].Price.GetMinorUnits())
+	assert.Equal(t, int32(3), state.Receipts["a@example.com"].Seat.SeatNumber)
+	assert.Equal(t, []string{"Assigned"}, state.Seats["B"][3])
+}
+
+func TestFileStoreReadsSingleStateSeats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"receipts":{},"seats":{"A":{"1":"Assigned","2":["Available"]}}}`), 0o644))
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	state, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, map[int][]string{1: {"Assigned"}, 2: {"Available"}}, state.Seats["A"])
+
+	// A single state applies to every segment of the seat.
+	seatManager := NewRouteSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 2}}, []string{"London", "Paris", "Brussels"}, store)
+	seatManager.restoreSeats(state.Seats)
+	assert.Equal(t, []string{"Assigned", "Assigned"}, seatManager.Sections["A"].Occupancy[1])
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"seats":{"A":{"1":7}}}`), 0o644))
+	_, err = NewFileStore(path)
+	assert.Error(t, err)
+}
+
+func TestFileStoreReadsLegacyPrices(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.json")
+	legacy := `{"receipts":{"a@example.com":{"from":"London","to":"Paris","price":20,"discount":2.555,
+		"legs":[{"from":"London","to":"Paris","price":20,"basePrice":22.5}]}},"seats":{}}`
+	require.NoError(t, os.WriteFile(path, []byte(legacy), 0o644))
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	state, err := store.Load()
+	require.NoError(t, err)
+	receipt := state.Receipts["a@example.com"]
+	require.NotNil(t, receipt)
+	assert.Equal(t, newMoney(DefaultCurrency, 2000), receipt.Price, "Plain prices were in pounds")
+	assert.Equal(t, int64(256), receipt.Discount.GetMinorUnits(), "Fractions of a penny round half up")
+	assert.Equal(t, int64(2000), receipt.Legs[0].Price.GetMinorUnits())
+	assert.Equal(t, int64(2250), receipt.Legs[0].BasePrice.GetMinorUnits())
+}
+
+func TestRestoreFromFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookings.json")
+	sectionConfigs := []SectionConfigs{
+		{SectionName: "A", MaxSeats: 2},
+		{SectionName: "B", MaxSeats: 2},
+	}
+	stationConnection := map[string]int64{"London-France": 2000}
+
+	store, err := NewFileStore(path)
+	require.NoError(t, err)
+	tm := NewTicketManager(NewSeatManager(sectionConfigs, store), stationConnection, store)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{FirstName: "Nandha", LastName: "Kumar", Email: "restore@example.com"},
+		From: "London",
+		To:   "France",
+	})
+	require.NoError(t, err)
+
+	// Simulate a restart by building a fresh manager over the same file.
+	store, err = NewFileStore(path)
+	require.NoError(t, err)
+	restarted := NewTicketManager(NewSeatManager(sectionConfigs, store), stationConnection, store)
+	require.NoError(t, restarted.Restore())
+
+	restored, err := restarted.GetReceipt(context.Background(), &pb.GetReceiptRequest{Email: "restore@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, receipt.Seat.SeatNumber, restored.Seat.SeatNumber)
+	assert.Equal(t, "Assigned", restarted.SeatManager.Sections[receipt.Seat.Section].SeatState(int(receipt.Seat.SeatNumber)))
+}
+
+func TestRestoreReconcilesSeats(t *testing.T) {
+	store := NewMemoryStore()
+	sectionConfigs := []SectionConfigs{{SectionName: "A", MaxSeats: 3}}
+
+	// Seat 1 is assigned without a receipt, seat 2 has a receipt but was never
+	// marked assigned and seat 3 was held when the server stopped.
+	require.NoError(t, store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{
+			"held@example.com": {User: &pb.User{Email: "held@example.com"}, Seat: &pb.Seat{SeatNumber: 2, Section: "A"}},
+		},
+		Seats: map[string]map[int][]string{"A": {1: {"Assigned"}, 3: {"Held"}}},
+	}))
+
+	tm := NewTicketManager(NewSeatManager(sectionConfigs, store), map[string]int64{}, store)
+	require.NoError(t, tm.Restore())
+
+	section := tm.SeatManager.Sections["A"]
+	assert.Equal(t, "Available", section.SeatState(1), "Orphaned seat should be released")
+	assert.Equal(t, "Assigned", section.SeatState(2), "Seat referenced by a receipt should be assigned")
+	assert.Equal(t, "Available", section.SeatState(3), "Holds do not survive a restart")
+
+	state, _ := store.Load()
+	assert.Equal(t, []string{"Available"}, state.Seats["A"][1])
+	assert.Equal(t, []string{"Assigned"}, state.Seats["A"][2])
+}
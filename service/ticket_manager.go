@@ -4,32 +4,271 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"maps"
+	"sort"
 	"sync"
+	"time"
 
 	pb "github.com/nandha854/train-ticket-service/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // TicketManager handles ticket purchases, retrievals, and modifications.
-// It interacts with SeatManager to manage seat assignments for tickets.
+// It interacts with the SeatManager of each departure to manage seat assignments for tickets.
 type TicketManager struct {
 	pb.UnimplementedTicketServiceServer
 	SeatManager *SeatManager
+	Departures  map[string]*Departure
+	// Receipts holds every booking keyed by its booking reference.
 	Receipts    map[string]*pb.TicketReceipt
+	// pending holds the receipts of bookings being paid for, keyed by
+	// booking reference, while t.mu is released for the payment.
+	pending     map[string]*pb.TicketReceipt
 	mu          sync.Mutex
-	StationConnection map[string]float64
+	Routes      *RouteGraph
+	store       Store
+	// holds holds the journeys held for checkout, keyed by hold ID.
+	holds       map[string]*journeyHold
+	// waitlists queues passengers per departure ID; waitlistEntries indexes
+	// every entry, including promoted ones, by waitlist ID.
+	waitlists       map[string]*waitlist
+	waitlistEntries map[string]*waitlistEntry
+	// strategies holds the seat allocation strategy chosen per train ID.
+	strategies map[string]AllocationStrategy
+	// promoCodes holds the promo codes keyed by their normalized code.
+	promoCodes map[string]*PromoCode
+	// concessions and passengerValidators hold the fare multiplier and the
+	// booking check of each passenger type.
+	concessions         map[PassengerType]float64
+	passengerValidators map[PassengerType]PassengerValidator
+	// Pricing adjusts fares by occupancy and time to departure.
+	Pricing *PricingEngine
+	// Fees adds booking and seat reservation fees and VAT to fares.
+	Fees *FeeCalculator
+	// Currency is the currency fares and receipts are priced in; set it
+	// before taking bookings. Rates converts prices into display currencies.
+	Currency string
+	Rates    *ExchangeRates
+	// Payments takes payment for bookings before they are issued; nil
+	// issues them without payment. PaymentTimeout bounds an authorization.
+	Payments       PaymentProvider
+	PaymentTimeout time.Duration
+	// quotes holds the locked price quotes, keyed by quote ID.
+	quotes map[string]*priceQuote
+	// cancellationPolicies holds the cancellation policy of each fare class,
+	// and refunds the refund of every cancelled booking keyed by refund ID.
+	cancellationPolicies map[string]CancellationPolicy
+	refunds              map[string]*pb.RefundRecord
+	// manifests indexes the bookings and holds of each section by departure
+	// ID and section.
+	manifests map[string]map[string]*sectionManifest
 }
 
 // NewTicketManager initializes a new TicketManager with a SeatManager and an empty receipts map.
-func NewTicketManager(seatManager *SeatManager, stationConnection map[string]float64) *TicketManager {
+// The SeatManager serves the default departure; more departures are added with AddDeparture.
+// The "From-To" station connections seed the route graph used to price journeys,
+// with fares in minor units of DefaultCurrency.
+// Receipts are committed to the given store; call Restore to load previously saved bookings.
+func NewTicketManager(seatManager *SeatManager, stationConnection map[string]int64, store Store) *TicketManager {
+	seatManager.departureID = DefaultDepartureID
+
 	return &TicketManager{
 		SeatManager: seatManager,
+		Departures: map[string]*Departure{
+			DefaultDepartureID: {ID: DefaultDepartureID, SeatManager: seatManager},
+		},
 		Receipts:    make(map[string]*pb.TicketReceipt),
-		StationConnection: stationConnection,
+		pending:     make(map[string]*pb.TicketReceipt),
+		Routes:      NewRouteGraph(stationConnection),
+		store:       store,
+		holds:       make(map[string]*journeyHold),
+		waitlists:       make(map[string]*waitlist),
+		waitlistEntries: make(map[string]*waitlistEntry),
+		strategies:      make(map[string]AllocationStrategy),
+		Pricing:         &PricingEngine{},
+		Fees:            &FeeCalculator{},
+		Currency:        DefaultCurrency,
+		Rates:           NewExchangeRates(),
+		PaymentTimeout:  DefaultPaymentTimeout,
+		quotes:          make(map[string]*priceQuote),
+		promoCodes:      make(map[string]*PromoCode),
+		concessions:         maps.Clone(DefaultConcessions),
+		passengerValidators: defaultPassengerValidators(),
+		cancellationPolicies: make(map[string]CancellationPolicy),
+		refunds:              make(map[string]*pb.RefundRecord),
+		manifests:            make(map[string]map[string]*sectionManifest),
+	}
+}
+
+// AddDeparture registers a departure with its own seat inventory. Departures
+// must be added before Restore so that their persisted seats are loaded.
+func (t *TicketManager) AddDeparture(config DepartureConfig) (*Departure, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.Departures[config.ID]; ok {
+		return nil, fmt.Errorf("departure %s already exists", config.ID)
+	}
+
+	seatManager := NewRouteSeatManager(config.Sections, config.Stops, t.store)
+	seatManager.departureID = config.ID
+	if strategy, ok := t.strategies[config.TrainID]; ok {
+		seatManager.SetStrategy(strategy)
+	}
+
+	departure := &Departure{
+		ID:            config.ID,
+		TrainID:       config.TrainID,
+		ServiceDate:   config.ServiceDate,
+		DepartureTime: config.DepartureTime,
+		SeatManager:   seatManager,
+	}
+	t.Departures[config.ID] = departure
+
+	return departure, nil
+}
+
+// SetAllocationStrategy chooses how seats are allocated on every departure of
+// a train, including departures added later. The default departure has no
+// train ID, so an empty train ID selects it.
+func (t *TicketManager) SetAllocationStrategy(trainID string, strategy AllocationStrategy) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.strategies[trainID] = strategy
+	for _, departure := range t.Departures {
+		if departure.TrainID == trainID {
+			departure.SeatManager.SetStrategy(strategy)
+		}
 	}
 }
 
+// departure looks up a departure by ID, treating an empty ID as the default departure.
+func (t *TicketManager) departure(id string) (*Departure, bool) {
+	if id == "" {
+		id = DefaultDepartureID
+	}
+	departure, ok := t.Departures[id]
+	return departure, ok
+}
+
+// Restore loads receipts and seat states from the store and reconciles them.
+// A crash between the seat and receipt commits of one request can leave the two
+// out of step: seats covered by a receipt are marked assigned again, and assigned
+// seats that no receipt refers to are released. Seat holds do not survive a
+// restart, so held seats are released as well. Blocked seats stay blocked.
+func (t *TicketManager) Restore() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, err := t.store.Load()
+	if err != nil {
+		return fmt.Errorf("load booking state: %w", err)
+	}
+
+	t.Receipts = state.Receipts
+	t.refunds = state.Refunds
+	t.restorePromoCodes(state.PromoCodes)
+	for key, receipt := range t.Receipts {
+		// Receipts saved before booking references existed are keyed by email.
+		if receipt.BookingReference == "" {
+			receipt.BookingReference = key
+		}
+	}
+	t.rebuildManifests()
+	for _, departure := range t.Departures {
+		departure.SeatManager.restoreSections(state.Sections)
+		departure.SeatManager.restoreSeats(state.Seats)
+	}
+
+	return t.reconcileSeats()
+}
+
+// reconcileSeats makes seat occupancy agree with the receipts. Callers must hold t.mu.
+func (t *TicketManager) reconcileSeats() error {
+	// booked records, per store key and seat, the segments covered by a receipt.
+	booked := make(map[string]map[int][]bool)
+	for email, receipt := range t.Receipts {
+		for _, leg := range seatedLegs(receipt) {
+			departure, ok := t.departure(leg.GetDepartureId())
+			if !ok {
+				log.Printf("Restore receipt %s refers to unknown departure %s", email, leg.GetDepartureId())
+				continue
+			}
+			start, end, err := departure.SeatManager.segmentRange(leg.GetFrom(), leg.GetTo())
+			if err != nil {
+				log.Printf("Restore receipt %s has an invalid journey: %v", email, err)
+				continue
+			}
+
+			key := departureStoreKey(departure.ID, leg.GetSeat().GetSection())
+			seat := int(leg.GetSeat().GetSeatNumber())
+			if booked[key] == nil {
+				booked[key] = make(map[int][]bool)
+			}
+			if booked[key][seat] == nil {
+				booked[key][seat] = make([]bool, max(len(departure.SeatManager.Stops)-1, 1))
+			}
+			for i := start; i < end; i++ {
+				booked[key][seat][i] = true
+			}
+		}
+	}
+
+	fixes := make(map[string]map[int][]string)
+	for _, departure := range t.Departures {
+		seatManager := departure.SeatManager
+		seatManager.mu.Lock()
+		for name, section := range seatManager.Sections {
+			key := departureStoreKey(departure.ID, name)
+			for seat, segments := range section.Occupancy {
+				updated := append([]string(nil), segments...)
+				changed := false
+				for i, state := range segments {
+					want := state
+					if booked[key][seat] != nil && booked[key][seat][i] {
+						want = "Assigned"
+					} else if state != "Available" && state != "Blocked" {
+						want = "Available"
+					}
+					if want != state {
+						updated[i] = want
+						changed = true
+					}
+				}
+				if !changed {
+					continue
+				}
+				if fixes[key] == nil {
+					fixes[key] = make(map[int][]string)
+				}
+				fixes[key][seat] = updated
+				section.Occupancy[seat] = updated
+			}
+		}
+		seatManager.mu.Unlock()
+	}
+
+	if len(fixes) == 0 {
+		return nil
+	}
+
+	log.Printf("Restore reconciled seats: %v", fixes)
+	return t.store.Commit(&Mutation{Seats: fixes})
+}
+
+// commitReceipt persists a receipt under key, or removes it when receipt is nil.
+func (t *TicketManager) commitReceipt(key string, receipt *pb.TicketReceipt) error {
+	return t.store.Commit(&Mutation{Receipts: map[string]*pb.TicketReceipt{key: receipt}})
+}
+
 // PurchaseTicket processes a ticket purchase request, assigns a seat, and returns a ticket receipt.
 func (t *TicketManager) PurchaseTicket(ctx context.Context, req *pb.PurchaseTicketRequest) (*pb.TicketReceipt, error) {
 	t.mu.Lock()
@@ -37,59 +276,297 @@ func (t *TicketManager) PurchaseTicket(ctx context.Context, req *pb.PurchaseTick
 
 	log.Printf("PurchaseTicket request received: %+v", req)
 
-	if req.User == nil || req.User.Email == "" || req.From == "" || req.To == "" {
+	if req.User == nil || req.User.Email == "" || (req.HoldId == "" && (req.From == "" || req.To == "")) {
 		log.Printf("PurchaseTicket request missing required fields: %+v", req)
 		return nil, status.Error(codes.InvalidArgument, "missing required fields")
 	}
 
-	// Validate the station names
-	connectionStations := fmt.Sprintf("%s-%s", req.From, req.To)
-	if t.StationConnection[connectionStations] == 0 {
-		log.Printf("PurchaseTicket request with invalid station: From=%s, To=%s", req.From, req.To)
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("PurchaseTicket request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+
+	if err := t.checkDisplayCurrency(req.DisplayCurrency); err != nil {
+		log.Printf("PurchaseTicket request with unknown display currency: %s", req.DisplayCurrency)
+		return nil, err
+	}
+
+	var receipt *pb.TicketReceipt
+	var err error
+	if req.HoldId != "" {
+		receipt, err = t.confirmHold(ctx, req.HoldId, []*pb.User{req.User}, req.PromoCodes, req.PaymentToken)
+	} else {
+		receipt, err = t.purchase(ctx, booking{
+			from:        req.From,
+			to:          req.To,
+			passengers:  []*pb.User{req.User},
+			preferences: req.SeatPreferences,
+			fareClass:   fareClassOrDefault(req.FareClass),
+			quoteID:     req.QuoteId,
+			promoCodes:  req.PromoCodes,
+			paymentToken: req.PaymentToken,
+		}, req.DepartureId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return t.displayReceipt(receipt, req.DisplayCurrency)
+}
+
+// PurchaseGroupTicket seats every passenger of a group on one booking, or none of them.
+func (t *TicketManager) PurchaseGroupTicket(ctx context.Context, req *pb.PurchaseGroupTicketRequest) (*pb.TicketReceipt, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("PurchaseGroupTicket request received: %+v", req)
+
+	if len(req.Passengers) == 0 || req.Passengers[0].GetEmail() == "" || req.From == "" || req.To == "" {
+		log.Printf("PurchaseGroupTicket request missing required fields: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+	for _, passenger := range req.Passengers {
+		if passenger == nil || (passenger.FirstName == "" && passenger.LastName == "") {
+			log.Printf("PurchaseGroupTicket request with unnamed passenger: %+v", req)
+			return nil, status.Error(codes.InvalidArgument, "every passenger needs a name")
+		}
+	}
+
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("PurchaseGroupTicket request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+
+	if err := t.checkDisplayCurrency(req.DisplayCurrency); err != nil {
+		log.Printf("PurchaseGroupTicket request with unknown display currency: %s", req.DisplayCurrency)
+		return nil, err
+	}
+
+	receipt, err := t.purchase(ctx, booking{
+		from:        req.From,
+		to:          req.To,
+		passengers:  req.Passengers,
+		preferences: req.SeatPreferences,
+		fareClass:   fareClassOrDefault(req.FareClass),
+		quoteID:     req.QuoteId,
+		promoCodes:  req.PromoCodes,
+		paymentToken: req.PaymentToken,
+	}, req.DepartureId)
+	if err != nil {
+		return nil, err
+	}
+	return t.displayReceipt(receipt, req.DisplayCurrency)
+}
+
+// booking is what a purchase is for: the journey, who travels and how.
+type booking struct {
+	from        string
+	to          string
+	passengers  []*pb.User
+	preferences *pb.SeatPreferences
+	fareClass   string
+	// quoteID names a price quote the booking is charged at.
+	quoteID string
+	// promoCodes are the promo codes asked for, and discounts the codes
+	// found valid for the booking.
+	promoCodes []string
+	discounts  []*PromoCode
+	// paymentToken is the card charged for the booking.
+	paymentToken string
+}
+
+// seatRequest returns the seats the booking may get.
+func (b booking) seatRequest() (SeatRequest, error) {
+	request, err := seatRequestFromProto(b.preferences)
+	if err != nil {
+		return SeatRequest{}, err
+	}
+	request.FareClass = b.fareClass
+	return request, nil
+}
+
+// purchase prices a journey, seats every passenger on each leg, takes payment
+// and stores the receipt. The first passenger owns the booking. Callers must
+// hold t.mu.
+func (t *TicketManager) purchase(ctx context.Context, b booking, departureID string) (*pb.TicketReceipt, error) {
+	request, err := b.seatRequest()
+	if err != nil {
+		log.Printf("PurchaseTicket request with invalid seat preferences: %v", err)
+		return nil, status.Error(codes.InvalidArgument, "invalid seat preferences")
+	}
+
+	// Validate the station names and find the journey
+	journey, err := t.Routes.FindJourney(b.from, b.to)
+	if err != nil {
+		log.Printf("PurchaseTicket request with invalid station: From=%s, To=%s", b.from, b.to)
 		return nil, status.Error(codes.InvalidArgument, "invalid station")
 	}
 
-	seat, section, err := t.SeatManager.AssignSeat()
+	departure, ok := t.departure(departureID)
+	if !ok {
+		log.Printf("PurchaseTicket request with unknown departure: %s", departureID)
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+
+	if err := t.validatePassengers(b.passengers, departure.travelDate(time.Now())); err != nil {
+		log.Printf("PurchaseTicket passenger rejected: %v", err)
+		return nil, err
+	}
+
+	if b.discounts, err = t.validPromoCodes(b, time.Now()); err != nil {
+		log.Printf("PurchaseTicket promo code rejected: %v", err)
+		return nil, err
+	}
+
+	var quote *priceQuote
+	if b.quoteID != "" {
+		if quote, err = t.lockedQuote(b.quoteID, b, departureID); err != nil {
+			return nil, err
+		}
+	}
+
+	booked, err := t.bookJourney(departure, departureID != "", journey, seatedPassengers(b.passengers), request)
 	if err != nil {
 		log.Printf("PurchaseTicket seat assignment failed: %v", err)
 		return nil, err
 	}
 
+	if quote != nil {
+		if err := quote.apply(booked); err != nil {
+			log.Printf("PurchaseTicket quote %s failed: %v", b.quoteID, err)
+			if releaseErr := t.releasePassengerLegs(booked); releaseErr != nil {
+				log.Printf("PurchaseTicket seat release failed: %v", releaseErr)
+			}
+			return nil, err
+		}
+	}
+
+	// The quote is used up while the booking is paid for, and given back if
+	// the booking fails.
+	if quote != nil {
+		delete(t.quotes, b.quoteID)
+	}
+	receipt, err := t.issueReceipt(ctx, b, booked)
+	if err != nil {
+		if quote != nil {
+			t.quotes[b.quoteID] = quote
+		}
+		return nil, err
+	}
+	return receipt, nil
+}
+
+// issueReceipt creates and stores the receipt of a booking whose seats are
+// already booked for each seated passenger, in order. The booking is paid for
+// first, with t.mu released, and only stored once the payment is captured.
+// On failure the seats are released. Callers must hold t.mu.
+func (t *TicketManager) issueReceipt(ctx context.Context, b booking, booked [][]*pb.Leg) (*pb.TicketReceipt, error) {
+	receipt, err := t.newReceipt(b, booked)
+	if err != nil {
+		log.Printf("PurchaseTicket booking reference failed: %v", err)
+		if releaseErr := t.releasePassengerLegs(booked); releaseErr != nil {
+			log.Printf("PurchaseTicket seat release failed: %v", releaseErr)
+		}
+		return nil, status.Error(codes.Internal, "failed to create booking reference")
+	}
+
+	if err := t.payForReceipt(ctx, receipt, b.paymentToken); err != nil {
+		log.Printf("PurchaseTicket payment failed: %v", err)
+		if releaseErr := t.releasePassengerLegs(booked); releaseErr != nil {
+			log.Printf("PurchaseTicket seat release failed: %v", releaseErr)
+		}
+		return nil, err
+	}
+	return t.storeReceipt(ctx, receipt, booked)
+}
+
+// newReceipt prices and itemizes the receipt of a booking under a new booking
+// reference. Callers must hold t.mu.
+func (t *TicketManager) newReceipt(b booking, booked [][]*pb.Leg) (*pb.TicketReceipt, error) {
+	reference, err := newBookingReference(t.Receipts, t.pending)
+	if err != nil {
+		return nil, err
+	}
+
+	travelling := t.passengerLegs(b.passengers, booked)
+	breakdown := t.Fees.Breakdown(travelling)
+	discount, discounts := applyPromoCodes(b.discounts, newMoney(t.Currency, breakdown.Total()))
+
+	legs := travelling[0]
 	receipt := &pb.TicketReceipt{
-		User:  req.User,
-		From:  req.From,
-		To:    req.To,
-		Price: t.StationConnection[connectionStations],
-		Seat:  &pb.Seat{SeatNumber: int32(seat), Section: section},
+		User:  b.passengers[0],
+		From:  b.from,
+		To:    b.to,
+		Discount: discount,
+		Discounts: discounts,
+		Seat:  legs[0].Seat,
+		DepartureId: legs[0].DepartureId,
+		Legs:  legs,
+		BookingReference: reference,
+		SeatPreferences: b.preferences,
+		FareClass: b.fareClass,
+	}
+	if len(b.passengers) > 1 {
+		for p, passenger := range b.passengers {
+			receipt.Passengers = append(receipt.Passengers, &pb.Passenger{User: passenger, Legs: travelling[p]})
+		}
+	}
+	receipt.SatisfiedPreferences = satisfiedEverywhere(b.preferences.GetPreferred(), seatedLegs(receipt))
+	receipt.PriceAdjustments = priceAdjustments(bookedLegs(receipt))
+	t.itemize(receipt, breakdown)
+	return receipt, nil
+}
+
+// storeReceipt stores a paid receipt whose seats are assigned. If it cannot
+// be saved, the payment is refunded and the seats are released. Callers must
+// hold t.mu.
+func (t *TicketManager) storeReceipt(ctx context.Context, receipt *pb.TicketReceipt, booked [][]*pb.Leg) (*pb.TicketReceipt, error) {
+	reference := receipt.BookingReference
+	if err := t.commitReceipt(reference, receipt); err != nil {
+		log.Printf("PurchaseTicket receipt persist failed: %v", err)
+		t.refundPayment(ctx, receipt.PaymentId, receipt.Price)
+		if releaseErr := t.releasePassengerLegs(booked); releaseErr != nil {
+			log.Printf("PurchaseTicket seat release failed: %v", releaseErr)
+		}
+		return nil, status.Error(codes.Internal, "failed to save ticket")
 	}
 
-	t.Receipts[req.User.Email] = receipt
+	t.putReceipt(reference, receipt)
 
 	log.Printf("PurchaseTicket successful: %+v", receipt)
 	return receipt, nil
 }
 
-// GetReceipt retrieves the ticket receipt for a given email.
+// GetReceipt retrieves the ticket receipt for a given booking reference or email.
 func (t *TicketManager) GetReceipt(ctx context.Context, req *pb.GetReceiptRequest) (*pb.TicketReceipt, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	log.Printf("GetReceipt request received: %+v", req)
 
-	if req.Email == "" {
-		log.Printf("GetReceipt request missing email: %+v", req)
-		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	_, receipt, err := t.findReceipt(req.Email, req.BookingReference)
+	if err != nil {
+		log.Printf("GetReceipt lookup failed: email=%s, reference=%s: %v", req.Email, req.BookingReference, err)
+		return nil, err
 	}
 
-	receipt, ok := t.Receipts[req.Email]
-	if !ok {
-		log.Printf("GetReceipt not found for email: %s", req.Email)
-		return nil, status.Error(codes.NotFound, "ticket receipt not found")
+	receipt, err = t.displayReceipt(receipt, req.DisplayCurrency)
+	if err != nil {
+		log.Printf("GetReceipt request with unknown display currency: %s", req.DisplayCurrency)
+		return nil, err
 	}
 
 	log.Printf("GetReceipt successful: %+v", receipt)
 	return receipt, nil
 }
 
-// GetUsersBySection retrieves a list of users seated in a specific section.
+// GetUsersBySection lists the seats of a section on a departure, one entry per
+// passenger and leg, a page at a time. Entries are sorted by seat number or
+// surname and may be filtered by journey, name and whether the seat is booked
+// or held.
 func (t *TicketManager) GetUsersBySection(ctx context.Context, req *pb.GetUsersBySectionRequest) (*pb.UsersBySectionResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	log.Printf("GetUsersBySection request received: %+v", req)
 
 	if req.Section == "" {
@@ -97,71 +574,300 @@ func (t *TicketManager) GetUsersBySection(ctx context.Context, req *pb.GetUsersB
 		return nil, status.Error(codes.InvalidArgument, "missing required fields")
 	}
 
-	users := []*pb.UserTicket{}
-	for _, receipt := range t.Receipts {
-		if receipt.Seat.Section == req.Section {
-			users = append(users, &pb.UserTicket{User: receipt.User, Seat: receipt.Seat})
-		}
+	departure, ok := t.departure(req.DepartureId)
+	if !ok {
+		log.Printf("GetUsersBySection request with unknown departure: %s", req.DepartureId)
+		return nil, status.Error(codes.NotFound, "departure not found")
 	}
 
-	log.Printf("GetUsersBySection successful: section=%s, users=%d", req.Section, len(users))
-	return &pb.UsersBySectionResponse{Users: users}, nil
+	response, err := t.manifestPage(departure, req, time.Now())
+	if err != nil {
+		log.Printf("GetUsersBySection request rejected: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Printf("GetUsersBySection successful: section=%s, users=%d of %d", req.Section, len(response.Users), response.TotalSize)
+	return response, nil
 }
 
-// RemoveUser cancels a ticket and releases the assigned seat.
+// RemoveUser cancels a ticket, addressed by booking reference or email, and releases the assigned seat.
 func (t *TicketManager) RemoveUser(ctx context.Context, req *pb.RemoveUserRequest) (*pb.RemoveUserResponse, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	log.Printf("RemoveUser request received: %+v", req)
 
-	if req.Email == "" {
-		log.Printf("RemoveUser request missing email: %+v", req)
-		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	key, receipt, err := t.findReceipt(req.Email, req.BookingReference)
+	if err != nil {
+		log.Printf("RemoveUser lookup failed: email=%s, reference=%s: %v", req.Email, req.BookingReference, err)
+		return nil, err
 	}
 
-	receipt, ok := t.Receipts[req.Email]
-	if !ok {
-		log.Printf("RemoveUser not found for email: %s", req.Email)
-		return nil, status.Error(codes.NotFound, "ticket receipt not found")
+	refund, err := t.newRefund(receipt, time.Now())
+	if err != nil {
+		log.Printf("RemoveUser refund id failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to cancel ticket")
+	}
+
+	// Save the cancellation, with its refund pending, before paying the
+	// refund so that a failed commit refunds nothing. Drop the receipt before
+	// the seat so a crash in between leaves an unreferenced seat, which
+	// Restore releases, rather than a lost ticket.
+	if err := t.store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{key: nil},
+		Refunds:  map[string]*pb.RefundRecord{refund.RefundId: refund},
+	}); err != nil {
+		log.Printf("RemoveUser receipt persist failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to cancel ticket")
 	}
 
-	if err := t.SeatManager.ReleaseSeat(int(receipt.Seat.SeatNumber), receipt.Seat.Section); err != nil {
+	t.deleteReceipt(key)
+	t.refunds[refund.RefundId] = refund
+
+	message := "Ticket cancelled successfully"
+	if err := t.payRefund(ctx, refund); err != nil {
+		log.Printf("RemoveUser refund %s failed: %v", refund.RefundId, err)
+		if refund.RefundPending {
+			message = "Ticket cancelled; refund pending"
+		}
+	}
+
+	err = t.releaseLegs(seatedLegs(receipt))
+	t.promoteWaitlists()
+	if err != nil {
 		log.Printf("RemoveUser seat release failed: %v", err)
 		return nil, err
 	}
 
-	delete(t.Receipts, req.Email)
-
-	log.Printf("RemoveUser successful: email=%s", req.Email)
-	return &pb.RemoveUserResponse{Message: "Ticket cancelled successfully"}, nil
+	log.Printf("RemoveUser successful: reference=%s, refund=%s", key, FormatMoney(refund.Amount))
+	return &pb.RemoveUserResponse{Message: message, Refund: proto.Clone(refund).(*pb.RefundRecord)}, nil
 }
 
-// ModifyUserSeat changes the seat assignment for a user.
+// ModifyUserSeat changes the seat assignment for a booking, addressed by booking reference or email.
+// Moving to a section of another fare class reprices the leg at that class's fare; the
+// returned receipt carries the fare difference. Class changes are refused while a payment
+// provider is set, since the difference would go uncollected or unrefunded.
 func (t *TicketManager) ModifyUserSeat(ctx context.Context, req *pb.ModifyUserSeatRequest) (*pb.TicketReceipt, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	log.Printf("ModifyUserSeat request received: %+v", req)
 
-	if req.Email == "" || req.NewSeat == nil || req.NewSeat.Section == "" || req.NewSeat.SeatNumber == 0 {
+	if (req.Email == "" && req.BookingReference == "") || req.NewSeat == nil || req.NewSeat.Section == "" || req.NewSeat.SeatNumber == 0 {
 		log.Printf("ModifyUserSeat request missing required fields: %+v", req)
 		return nil, status.Error(codes.InvalidArgument, "missing required fields")
 	}
 
-	receipt, ok := t.Receipts[req.Email]
+	key, receipt, err := t.findReceipt(req.Email, req.BookingReference)
+	if err != nil {
+		log.Printf("ModifyUserSeat lookup failed: email=%s, reference=%s: %v", req.Email, req.BookingReference, err)
+		return nil, err
+	}
+
+	passengers := receiptPassengers(receipt)
+	if req.Passenger < 0 || int(req.Passenger) >= len(passengers) {
+		log.Printf("ModifyUserSeat request with invalid passenger: %d", req.Passenger)
+		return nil, status.Error(codes.InvalidArgument, "invalid passenger")
+	}
+
+	legs := passengers[req.Passenger].Legs
+	if req.Leg < 0 || int(req.Leg) >= len(legs) {
+		log.Printf("ModifyUserSeat request with invalid leg: %d", req.Leg)
+		return nil, status.Error(codes.InvalidArgument, "invalid journey leg")
+	}
+	leg := legs[req.Leg]
+	if leg.Seat == nil {
+		log.Printf("ModifyUserSeat request for a passenger without a seat: %d", req.Passenger)
+		return nil, status.Error(codes.FailedPrecondition, "passenger has no seat")
+	}
+
+	seatManager, err := t.legSeats(leg)
+	if err != nil {
+		log.Printf("ModifyUserSeat seat lookup failed: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// A move into another fare class is repriced at that class's current fare.
+	oldClass, _ := seatManager.SectionFareClass(leg.Seat.Section)
+	newClass, ok := seatManager.SectionFareClass(req.NewSeat.Section)
 	if !ok {
-		log.Printf("ModifyUserSeat not found for email: %s", req.Email)
-		return nil, status.Error(codes.NotFound, "ticket receipt not found")
+		newClass = oldClass
+	}
+	priced := leg
+	if newClass != oldClass && t.Payments != nil {
+		log.Printf("ModifyUserSeat class change refused for paid booking %s", key)
+		return nil, status.Error(codes.FailedPrecondition, "fare class changes are not available for paid bookings; cancel and book again")
+	}
+	if newClass != oldClass {
+		fare, err := t.Routes.LegFare(newClass, leg.From, leg.To)
+		if err != nil {
+			log.Printf("ModifyUserSeat fare lookup failed: %v", err)
+			return nil, status.Error(codes.FailedPrecondition, "no fare for the new seat's class")
+		}
+		departure, _ := t.departure(leg.DepartureId)
+		priced = t.priceLeg(departure, JourneyLeg{From: leg.From, To: leg.To, Fare: fare}, newClass, time.Now())
+		passengerType, _ := passengerTypeOf(passengers[req.Passenger].User)
+		t.applyConcession(priced, passengerType)
 	}
 
-	if err := t.SeatManager.ModifySeat(int(receipt.Seat.SeatNumber), receipt.Seat.Section, int(req.NewSeat.SeatNumber), req.NewSeat.Section); err != nil {
+	if err := seatManager.ModifySeat(int(leg.Seat.SeatNumber), leg.Seat.Section, int(req.NewSeat.SeatNumber), req.NewSeat.Section, leg.From, leg.To); err != nil {
 		log.Printf("ModifyUserSeat seat modification failed: %v", err)
 		return nil, status.Error(codes.InvalidArgument, "seat modification failed - " + err.Error())
 	}
 
-	receipt.Seat = req.NewSeat
+	// Recompute which preferences the new seat meets; requirements only
+	// constrain seats chosen for the passenger, not seats they pick.
+	request, _ := seatRequestFromProto(receipt.SeatPreferences)
+	satisfied := seatFeaturesToProto(request.satisfied(seatManager.SeatFeatures(req.NewSeat.Section, int(req.NewSeat.SeatNumber))))
+
+	updated := proto.Clone(receipt).(*pb.TicketReceipt)
+	moved := func(updatedLeg *pb.Leg) {
+		updatedLeg.Seat = req.NewSeat
+		updatedLeg.SatisfiedPreferences = satisfied
+		updatedLeg.FareClass = newClass
+		updatedLeg.Price = priced.Price
+		updatedLeg.BasePrice = priced.BasePrice
+		updatedLeg.PriceAdjustments = priceAdjustments([]*pb.Leg{priced})
+	}
+	if len(updated.Passengers) > 0 {
+		moved(updated.Passengers[req.Passenger].Legs[req.Leg])
+	}
+	if req.Passenger == 0 {
+		if len(updated.Legs) > 0 {
+			moved(updated.Legs[req.Leg])
+		}
+		if req.Leg == 0 {
+			updated.Seat = req.NewSeat
+		}
+	}
+	if newClass != oldClass {
+		// Fees, VAT and promo code discounts follow the new fare, so the
+		// whole receipt is itemized again, and cancellation follows the class
+		// now travelled in.
+		breakdown := t.Fees.Breakdown(receiptPassengerLegs(updated))
+		updated.Discount, updated.Discounts = applyPromoCodes(t.receiptPromoCodes(receipt), newMoney(t.Currency, breakdown.Total()))
+		t.itemize(updated, breakdown)
+		updated.FareClass = receiptFareClass(updated)
+	}
+	updated.PriceAdjustments = priceAdjustments(bookedLegs(updated))
+	updated.SatisfiedPreferences = satisfiedEverywhere(updated.SeatPreferences.GetPreferred(), seatedLegs(updated))
+	if err := t.commitReceipt(key, updated); err != nil {
+		log.Printf("ModifyUserSeat receipt persist failed: %v", err)
+		if revertErr := seatManager.ModifySeat(int(req.NewSeat.SeatNumber), req.NewSeat.Section, int(leg.Seat.SeatNumber), leg.Seat.Section, leg.From, leg.To); revertErr != nil {
+			log.Printf("ModifyUserSeat seat revert failed: %v", revertErr)
+		}
+		return nil, status.Error(codes.Internal, "failed to save seat change")
+	}
+
+	t.putReceipt(key, updated)
+
+	// The old seat is free now and may seat someone waiting.
+	t.promoteWaitlists()
+
+	// The fare difference belongs to this change only, so it is kept off the
+	// stored receipt.
+	difference := updated.Price.GetMinorUnits() - receipt.Price.GetMinorUnits()
+	receipt = proto.Clone(updated).(*pb.TicketReceipt)
+	receipt.FareDifference = newMoney(t.Currency, difference)
 
 	log.Printf("ModifyUserSeat successful: %+v", receipt)
 	return receipt, nil
 }
+
+// receiptFareClass returns the fare class every seat of a receipt is in, or
+// the class it was booked in when its seats are in different classes.
+func receiptFareClass(receipt *pb.TicketReceipt) string {
+	class := ""
+	for _, leg := range seatedLegs(receipt) {
+		if class != "" && leg.FareClass != class {
+			return receipt.FareClass
+		}
+		class = leg.FareClass
+	}
+	if class == "" {
+		return receipt.FareClass
+	}
+	return class
+}
+
+// ListBookingsByEmail returns every booking bought with an email, ordered by booking reference.
+func (t *TicketManager) ListBookingsByEmail(ctx context.Context, req *pb.ListBookingsByEmailRequest) (*pb.ListBookingsResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ListBookingsByEmail request received: %+v", req)
+
+	if req.Email == "" {
+		log.Printf("ListBookingsByEmail request missing email: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	receipts := []*pb.TicketReceipt{}
+	for _, receipt := range t.Receipts {
+		if receipt.GetUser().GetEmail() == req.Email {
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	sort.Slice(receipts, func(i, j int) bool {
+		return receipts[i].BookingReference < receipts[j].BookingReference
+	})
+
+	log.Printf("ListBookingsByEmail successful: email=%s, bookings=%d", req.Email, len(receipts))
+	return &pb.ListBookingsResponse{Receipts: receipts}, nil
+}
+
+// ListDepartures returns the departures on sale, optionally filtered by train and service date.
+func (t *TicketManager) ListDepartures(ctx context.Context, req *pb.ListDeparturesRequest) (*pb.ListDeparturesResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("ListDepartures request received: %+v", req)
+
+	departures := []*pb.Departure{}
+	for _, departure := range t.Departures {
+		if req.TrainId != "" && departure.TrainID != req.TrainId {
+			continue
+		}
+		if req.ServiceDate != "" && departure.ServiceDate != req.ServiceDate {
+			continue
+		}
+		departures = append(departures, departureToProto(departure))
+	}
+
+	sort.Slice(departures, func(i, j int) bool {
+		return departures[i].Id < departures[j].Id
+	})
+
+	log.Printf("ListDepartures successful: departures=%d", len(departures))
+	return &pb.ListDeparturesResponse{Departures: departures}, nil
+}
+
+// departureToProto converts a departure and its section sizes to the wire format.
+func departureToProto(departure *Departure) *pb.Departure {
+	result := &pb.Departure{
+		Id:          departure.ID,
+		TrainId:     departure.TrainID,
+		ServiceDate: departure.ServiceDate,
+		Stops:       departure.SeatManager.Stops,
+	}
+	if !departure.DepartureTime.IsZero() {
+		result.DepartureTime = timestamppb.New(departure.DepartureTime)
+	}
+
+	for _, name := range departure.SeatManager.nextSections {
+		result.Sections = append(result.Sections, sectionToProto(departure.SeatManager.Sections[name]))
+	}
+	return result
+}
+
+// sectionToProto converts a section's size, class and whether it is closed to
+// the wire format.
+func sectionToProto(section *Section) *pb.SectionInfo {
+	return &pb.SectionInfo{
+		Name:      section.Name,
+		MaxSeats:  int32(section.MaxSeats),
+		FareClass: section.FareClass,
+		Closed:    section.Closed,
+	}
+}
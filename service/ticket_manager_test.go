@@ -15,11 +15,12 @@ func createTestTicketManager() *TicketManager {
         {SectionName: "A", MaxSeats: 50},
         {SectionName: "B", MaxSeats: 50},
     }
-	stationConnection := map[string]float64{
-		"London-France": 20.00,
+	stationConnection := map[string]int64{
+		"London-France": 2000,
 	}
-    seatManager := NewSeatManager(sectionConfigs)
-    return NewTicketManager(seatManager, stationConnection)
+    store := NewMemoryStore()
+    seatManager := NewSeatManager(sectionConfigs, store)
+    return NewTicketManager(seatManager, stationConnection, store)
 }
 
 func TestNewTicketManager(t *testing.T) {
@@ -136,14 +137,14 @@ func TestGetUsersBySection(t *testing.T) {
     tm := createTestTicketManager()
 
     // Adding users to Section "A"
-    tm.Receipts["user1@example.com"] = &pb.TicketReceipt{
+    tm.putReceipt("user1@example.com", &pb.TicketReceipt{
         User: &pb.User{FirstName: "Nandha", LastName: "Kumar", Email: "user1@example.com"},
         Seat: &pb.Seat{SeatNumber: 1, Section: "A"},
-    }
-    tm.Receipts["user2@example.com"] = &pb.TicketReceipt{
+    })
+    tm.putReceipt("user2@example.com", &pb.TicketReceipt{
         User: &pb.User{FirstName: "Test", LastName: "User", Email: "user2@example.com"},
         Seat: &pb.Seat{SeatNumber: 2, Section: "A"},
-    }
+    })
 
     tests := []struct {
         name        string
@@ -197,7 +198,7 @@ func TestModifyUserSeat(t *testing.T) {
     seatNumber, section := 10, "A"
 
     // Assign a seat using SeatManager
-    tm.SeatManager.Sections[section].AvailableSeats[seatNumber] = "Assigned"
+    tm.SeatManager.Sections[section].Occupancy[seatNumber] = []string{"Assigned"}
 
     tm.Receipts[userEmail] = &pb.TicketReceipt{
         User: &pb.User{FirstName: "Kumar", LastName: "Test", Email: userEmail},
@@ -272,7 +273,7 @@ func TestRemoveUser(t *testing.T) {
     // Assign a seat using SeatManager
     seatNumber := 1
     section := "A"
-    tm.SeatManager.Sections[section].AvailableSeats[seatNumber] = "Assigned"
+    tm.SeatManager.Sections[section].Occupancy[seatNumber] = []string{"Assigned"}
 
     tm.Receipts[userEmail] = &pb.TicketReceipt{
         User: &pb.User{FirstName: "Kumar", LastName: "Test", Email: userEmail},
@@ -283,5 +284,5 @@ func TestRemoveUser(t *testing.T) {
     assert.NoError(t, err, "User removal should be successful")
     assert.Equal(t, "Ticket cancelled successfully", resp.Message)
     assert.NotContains(t, tm.Receipts, userEmail, "User should be removed from receipts")
-    assert.Equal(t, "Available", tm.SeatManager.Sections[section].AvailableSeats[seatNumber], "Seat should be available after removal")
+    assert.Equal(t, "Available", tm.SeatManager.Sections[section].SeatState(seatNumber), "Seat should be available after removal")
 }
\ No newline at end of file
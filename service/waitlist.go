@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// waitlistEntry is a passenger waiting for a seat on a departure.
+type waitlistEntry struct {
+	id          string
+	from        string
+	to          string
+	user        *pb.User
+	departureID string
+	// required is set when the passenger asked for this departure, so every
+	// journey must start on it.
+	required bool
+	// priority is zero until changed with AdminService.
+	priority  int32
+	fareClass string
+	// paymentToken is charged when the entry is promoted.
+	paymentToken string
+	// bookingReference is set once the entry has been promoted into a seat.
+	bookingReference string
+	// failure is set when the entry left the queue without a booking.
+	failure string
+	// promoting is set while the entry is being booked and paid for.
+	promoting bool
+}
+
+// waitlist queues the passengers waiting for one departure, highest priority
+// first and then in joining order.
+type waitlist struct {
+	entries []*waitlistEntry
+}
+
+// add queues an entry behind every entry of the same or a higher priority.
+func (w *waitlist) add(entry *waitlistEntry) {
+	i := sort.Search(len(w.entries), func(i int) bool {
+		return w.entries[i].priority < entry.priority
+	})
+	w.entries = append(w.entries, nil)
+	copy(w.entries[i+1:], w.entries[i:])
+	w.entries[i] = entry
+}
+
+// remove takes an entry out of the queue.
+func (w *waitlist) remove(id string) {
+	for i, entry := range w.entries {
+		if entry.id == id {
+			w.entries = append(w.entries[:i], w.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// position returns the one-based place of an entry, or zero if it is not queued.
+func (w *waitlist) position(id string) int {
+	for i, entry := range w.entries {
+		if entry.id == id {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// JoinWaitlist queues a passenger for a departure. Waiting passengers are
+// booked automatically as soon as a seat for their journey is freed; a
+// passenger who can be seated straight away is booked at once.
+func (t *TicketManager) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequest) (*pb.WaitlistEntry, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("JoinWaitlist request received: %+v", req)
+
+	if req.User == nil || req.User.Email == "" || req.From == "" || req.To == "" {
+		log.Printf("JoinWaitlist request missing required fields: %+v", req)
+		return nil, status.Error(codes.InvalidArgument, "missing required fields")
+	}
+
+	if err := t.checkFareClass(req.FareClass); err != nil {
+		log.Printf("JoinWaitlist request with unknown fare class: %s", req.FareClass)
+		return nil, err
+	}
+
+	if _, err := t.Routes.FindJourney(req.From, req.To); err != nil {
+		log.Printf("JoinWaitlist request with invalid station: From=%s, To=%s", req.From, req.To)
+		return nil, status.Error(codes.InvalidArgument, "invalid station")
+	}
+
+	departure, ok := t.departure(req.DepartureId)
+	if !ok {
+		log.Printf("JoinWaitlist request with unknown departure: %s", req.DepartureId)
+		return nil, status.Error(codes.NotFound, "departure not found")
+	}
+
+	if err := t.validatePassengers([]*pb.User{req.User}, departure.travelDate(time.Now())); err != nil {
+		log.Printf("JoinWaitlist passenger rejected: %v", err)
+		return nil, err
+	}
+
+	id, err := newReference(func(id string) bool {
+		_, taken := t.waitlistEntries["WL-"+id]
+		return taken
+	})
+	if err != nil {
+		log.Printf("JoinWaitlist waitlist id failed: %v", err)
+		return nil, status.Error(codes.Internal, "failed to join waitlist")
+	}
+
+	entry := &waitlistEntry{
+		id:           "WL-" + id,
+		from:         req.From,
+		to:           req.To,
+		user:         req.User,
+		departureID:  departure.ID,
+		required:     req.DepartureId != "",
+		fareClass:    fareClassOrDefault(req.FareClass),
+		paymentToken: req.PaymentToken,
+	}
+	if t.waitlists[departure.ID] == nil {
+		t.waitlists[departure.ID] = &waitlist{}
+	}
+	t.waitlists[departure.ID].add(entry)
+	t.waitlistEntries[entry.id] = entry
+
+	t.promoteWaitlists()
+
+	response := t.waitlistEntryToProto(entry)
+	log.Printf("JoinWaitlist successful: %+v", response)
+	return response, nil
+}
+
+// GetWaitlistPosition reports where a waitlist entry is in its queue, or the
+// booking it was promoted into.
+func (t *TicketManager) GetWaitlistPosition(ctx context.Context, req *pb.GetWaitlistPositionRequest) (*pb.WaitlistEntry, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("GetWaitlistPosition request received: %+v", req)
+
+	entry, ok := t.waitlistEntries[req.WaitlistId]
+	if !ok {
+		log.Printf("GetWaitlistPosition entry not found: %s", req.WaitlistId)
+		return nil, status.Error(codes.NotFound, "waitlist entry not found")
+	}
+
+	return t.waitlistEntryToProto(entry), nil
+}
+
+// LeaveWaitlist removes a passenger from a waitlist before they are promoted.
+func (t *TicketManager) LeaveWaitlist(ctx context.Context, req *pb.LeaveWaitlistRequest) (*pb.LeaveWaitlistResponse, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("LeaveWaitlist request received: %+v", req)
+
+	entry, ok := t.waitlistEntries[req.WaitlistId]
+	if !ok {
+		log.Printf("LeaveWaitlist entry not found: %s", req.WaitlistId)
+		return nil, status.Error(codes.NotFound, "waitlist entry not found")
+	}
+	if entry.bookingReference != "" {
+		log.Printf("LeaveWaitlist entry %s already promoted to %s", entry.id, entry.bookingReference)
+		return nil, status.Error(codes.FailedPrecondition, "already booked; cancel the booking instead")
+	}
+	if entry.promoting {
+		log.Printf("LeaveWaitlist entry %s is being booked", entry.id)
+		return nil, status.Error(codes.FailedPrecondition, "a seat is being booked; try again shortly")
+	}
+
+	t.waitlists[entry.departureID].remove(entry.id)
+	delete(t.waitlistEntries, entry.id)
+
+	log.Printf("LeaveWaitlist successful: %s", entry.id)
+	return &pb.LeaveWaitlistResponse{Message: "Left waitlist successfully"}, nil
+}
+
+// SetWaitlistPriority changes the priority of a waiting passenger, moving them
+// behind the entries that already have the new priority.
+func (a *AdminServer) SetWaitlistPriority(ctx context.Context, req *pb.SetWaitlistPriorityRequest) (*pb.WaitlistEntry, error) {
+	t := a.tickets
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("SetWaitlistPriority request received: %+v", req)
+
+	entry, ok := t.waitlistEntries[req.WaitlistId]
+	if !ok {
+		log.Printf("SetWaitlistPriority entry not found: %s", req.WaitlistId)
+		return nil, status.Error(codes.NotFound, "waitlist entry not found")
+	}
+	if entry.bookingReference != "" || entry.failure != "" {
+		log.Printf("SetWaitlistPriority entry %s is no longer queued", entry.id)
+		return nil, status.Error(codes.FailedPrecondition, "waitlist entry is no longer queued")
+	}
+
+	queue := t.waitlists[entry.departureID]
+	queue.remove(entry.id)
+	entry.priority = req.Priority
+	queue.add(entry)
+
+	response := t.waitlistEntryToProto(entry)
+	log.Printf("SetWaitlistPriority successful: %+v", response)
+	return response, nil
+}
+
+// promoteWaitlists books waiting passengers into seats that have become free.
+// Each queue is walked in order and every entry whose journey can now be
+// seated is booked, so a passenger waiting for a longer journey does not block
+// shorter ones behind them. Entries another call is already booking are
+// skipped, since t.mu is released while a promotion is paid for. Callers must
+// hold t.mu.
+func (t *TicketManager) promoteWaitlists() {
+	ids := make([]string, 0, len(t.waitlists))
+	for id := range t.waitlists {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		queue := t.waitlists[id]
+		for _, entry := range append([]*waitlistEntry(nil), queue.entries...) {
+			if entry.promoting || entry.bookingReference != "" || entry.failure != "" {
+				continue
+			}
+			err := t.promote(entry)
+			switch {
+			case err == nil:
+				queue.remove(entry.id)
+				log.Printf("Waitlist %s promoted to booking %s", entry.id, entry.bookingReference)
+			case entry.failure != "":
+				queue.remove(entry.id)
+				log.Printf("Waitlist %s dropped: %v", entry.id, err)
+			}
+		}
+	}
+}
+
+// promote tries to book the journey of a waitlist entry. When a seat is found
+// but the payment fails, the entry's failure is set: retrying on every freed
+// seat would authorize the payment again each time. Callers must hold t.mu.
+func (t *TicketManager) promote(entry *waitlistEntry) error {
+	journey, err := t.Routes.FindJourney(entry.from, entry.to)
+	if err != nil {
+		return err
+	}
+	departure, ok := t.departure(entry.departureID)
+	if !ok {
+		return status.Error(codes.NotFound, "departure not found")
+	}
+
+	b := booking{from: entry.from, to: entry.to, passengers: []*pb.User{entry.user}, fareClass: entry.fareClass, paymentToken: entry.paymentToken}
+	request, err := b.seatRequest()
+	if err != nil {
+		return err
+	}
+	booked, err := t.bookJourney(departure, entry.required, journey, 1, request)
+	if err != nil {
+		return err
+	}
+	// Promotion happens on behalf of whichever request freed the seat, so
+	// the payment is not tied to that request, but it is bounded by the
+	// payment timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), t.PaymentTimeout)
+	defer cancel()
+	entry.promoting = true
+	receipt, err := t.issueReceipt(ctx, b, booked)
+	entry.promoting = false
+	if err != nil {
+		if isPaymentFailure(err) {
+			entry.failure = status.Convert(err).Message()
+		}
+		return err
+	}
+
+	entry.bookingReference = receipt.BookingReference
+	return nil
+}
+
+// waitlistEntryToProto converts a waitlist entry to its API representation.
+// Callers must hold t.mu.
+func (t *TicketManager) waitlistEntryToProto(entry *waitlistEntry) *pb.WaitlistEntry {
+	response := &pb.WaitlistEntry{
+		WaitlistId:       entry.id,
+		From:             entry.from,
+		To:               entry.to,
+		User:             proto.Clone(entry.user).(*pb.User),
+		DepartureId:      entry.departureID,
+		Priority:         entry.priority,
+		BookingReference: entry.bookingReference,
+		FareClass:        entry.fareClass,
+		Failure:          entry.failure,
+	}
+	if entry.bookingReference == "" && entry.failure == "" {
+		response.Position = int32(t.waitlists[entry.departureID].position(entry.id))
+	}
+	return response
+}
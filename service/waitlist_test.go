@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createSoldOutTicketManager sets up a single-seat train whose seat is already sold.
+func createSoldOutTicketManager(t *testing.T) (*TicketManager, *pb.TicketReceipt) {
+	t.Helper()
+
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 1}}, store), map[string]int64{
+		"London-France": 2000,
+	}, store)
+
+	receipt, err := tm.PurchaseTicket(context.Background(), &pb.PurchaseTicketRequest{
+		User: &pb.User{Email: "first@example.com"}, From: "London", To: "France",
+	})
+	require.NoError(t, err)
+
+	return tm, receipt
+}
+
+func joinWaitlist(t *testing.T, tm *TicketManager, email string, priority int32) *pb.WaitlistEntry {
+	t.Helper()
+
+	entry, err := tm.JoinWaitlist(context.Background(), &pb.JoinWaitlistRequest{
+		User: &pb.User{Email: email}, From: "London", To: "France",
+	})
+	require.NoError(t, err)
+	if priority == 0 {
+		return entry
+	}
+
+	entry, err = NewAdminServer(tm).SetWaitlistPriority(context.Background(), &pb.SetWaitlistPriorityRequest{
+		WaitlistId: entry.WaitlistId, Priority: priority,
+	})
+	require.NoError(t, err)
+	return entry
+}
+
+func TestWaitlistOrder(t *testing.T) {
+	tm, _ := createSoldOutTicketManager(t)
+
+	first := joinWaitlist(t, tm, "fifo1@example.com", 0)
+	second := joinWaitlist(t, tm, "fifo2@example.com", 0)
+	urgent := joinWaitlist(t, tm, "urgent@example.com", 5)
+
+	tests := []struct {
+		name     string
+		entry    *pb.WaitlistEntry
+		position int32
+	}{
+		{name: "Higher priority goes first", entry: urgent, position: 1},
+		{name: "Equal priorities keep joining order", entry: first, position: 2},
+		{name: "Last to join waits longest", entry: second, position: 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, err := tm.GetWaitlistPosition(context.Background(), &pb.GetWaitlistPositionRequest{WaitlistId: tc.entry.WaitlistId})
+			require.NoError(t, err)
+			assert.Equal(t, tc.position, entry.Position)
+			assert.Empty(t, entry.BookingReference)
+		})
+	}
+}
+
+func TestSetWaitlistPriority(t *testing.T) {
+	tm, receipt := createSoldOutTicketManager(t)
+	admin := NewAdminServer(tm)
+	ctx := context.Background()
+
+	first := joinWaitlist(t, tm, "first@example.com", 0)
+	second := joinWaitlist(t, tm, "second@example.com", 0)
+	assert.Zero(t, first.Priority, "Entries join at priority zero")
+
+	lowered, err := admin.SetWaitlistPriority(ctx, &pb.SetWaitlistPriorityRequest{WaitlistId: first.WaitlistId, Priority: -1})
+	require.NoError(t, err)
+	assert.Equal(t, int32(-1), lowered.Priority)
+	assert.Equal(t, int32(2), lowered.Position)
+
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+	promoted, err := tm.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{WaitlistId: second.WaitlistId})
+	require.NoError(t, err)
+	assert.NotEmpty(t, promoted.BookingReference)
+
+	tests := []struct {
+		name         string
+		waitlistID   string
+		expectedCode codes.Code
+	}{
+		{name: "Unknown entry", waitlistID: "WL-NOPE", expectedCode: codes.NotFound},
+		{name: "Promoted entry", waitlistID: second.WaitlistId, expectedCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := admin.SetWaitlistPriority(ctx, &pb.SetWaitlistPriorityRequest{WaitlistId: tt.waitlistID, Priority: 5})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestWaitlistPromotion(t *testing.T) {
+	tm, receipt := createSoldOutTicketManager(t)
+
+	first := joinWaitlist(t, tm, "next@example.com", 0)
+	second := joinWaitlist(t, tm, "after@example.com", 0)
+	assert.Equal(t, int32(1), first.Position)
+
+	_, err := tm.RemoveUser(context.Background(), &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+
+	promoted, err := tm.GetWaitlistPosition(context.Background(), &pb.GetWaitlistPositionRequest{WaitlistId: first.WaitlistId})
+	require.NoError(t, err)
+	assert.Zero(t, promoted.Position)
+	require.NotEmpty(t, promoted.BookingReference)
+
+	booking, err := tm.GetReceipt(context.Background(), &pb.GetReceiptRequest{BookingReference: promoted.BookingReference})
+	require.NoError(t, err)
+	assert.Equal(t, "next@example.com", booking.User.Email)
+	assert.Equal(t, receipt.Seat, booking.Seat, "The freed seat goes to the waiting passenger")
+
+	waiting, err := tm.GetWaitlistPosition(context.Background(), &pb.GetWaitlistPositionRequest{WaitlistId: second.WaitlistId})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), waiting.Position)
+
+	_, err = tm.LeaveWaitlist(context.Background(), &pb.LeaveWaitlistRequest{WaitlistId: first.WaitlistId})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "A promoted entry cannot leave")
+}
+
+func TestWaitlistPromotionFromReleasedHold(t *testing.T) {
+	store := NewMemoryStore()
+	tm := NewTicketManager(NewSeatManager([]SectionConfigs{{SectionName: "A", MaxSeats: 1}}, store), map[string]int64{
+		"London-France": 2000,
+	}, store)
+
+	hold, err := tm.HoldSeats(context.Background(), &pb.HoldSeatsRequest{From: "London", To: "France"})
+	require.NoError(t, err)
+	entry := joinWaitlist(t, tm, "waiting@example.com", 0)
+	assert.Equal(t, int32(1), entry.Position)
+
+	_, err = tm.ReleaseHold(context.Background(), &pb.ReleaseHoldRequest{HoldId: hold.HoldId})
+	require.NoError(t, err)
+
+	promoted, err := tm.GetWaitlistPosition(context.Background(), &pb.GetWaitlistPositionRequest{WaitlistId: entry.WaitlistId})
+	require.NoError(t, err)
+	assert.NotEmpty(t, promoted.BookingReference)
+}
+
+func TestJoinWaitlistWithFreeSeat(t *testing.T) {
+	tm := createTestTicketManager()
+
+	entry := joinWaitlist(t, tm, "eager@example.com", 0)
+	assert.Zero(t, entry.Position)
+	assert.NotEmpty(t, entry.BookingReference, "A free seat is booked at once")
+}
+
+func TestLeaveWaitlist(t *testing.T) {
+	tm, _ := createSoldOutTicketManager(t)
+
+	first := joinWaitlist(t, tm, "leaver@example.com", 0)
+	second := joinWaitlist(t, tm, "stayer@example.com", 0)
+
+	tests := []struct {
+		name       string
+		waitlistID string
+		expectCode codes.Code
+	}{
+		{name: "Leave the waitlist", waitlistID: first.WaitlistId, expectCode: codes.OK},
+		{name: "Leave twice", waitlistID: first.WaitlistId, expectCode: codes.NotFound},
+		{name: "Unknown entry", waitlistID: "WL-NOPE", expectCode: codes.NotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tm.LeaveWaitlist(context.Background(), &pb.LeaveWaitlistRequest{WaitlistId: tc.waitlistID})
+			assert.Equal(t, tc.expectCode, status.Code(err))
+		})
+	}
+
+	entry, err := tm.GetWaitlistPosition(context.Background(), &pb.GetWaitlistPositionRequest{WaitlistId: second.WaitlistId})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), entry.Position)
+}
+
+func TestJoinWaitlistValidation(t *testing.T) {
+	tm := createTestTicketManager()
+
+	tests := []struct {
+		name       string
+		request    *pb.JoinWaitlistRequest
+		expectCode codes.Code
+	}{
+		{name: "Missing user", request: &pb.JoinWaitlistRequest{From: "London", To: "France"}, expectCode: codes.InvalidArgument},
+		{name: "Unknown station", request: &pb.JoinWaitlistRequest{User: &pb.User{Email: "a@example.com"}, From: "London", To: "Mars"}, expectCode: codes.InvalidArgument},
+		{name: "Unknown departure", request: &pb.JoinWaitlistRequest{User: &pb.User{Email: "a@example.com"}, From: "London", To: "France", DepartureId: "nope"}, expectCode: codes.NotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tm.JoinWaitlist(context.Background(), tc.request)
+			assert.Equal(t, tc.expectCode, status.Code(err))
+		})
+	}
+}
+
+func TestWaitlistPaymentFailure(t *testing.T) {
+	tm, receipt := createSoldOutTicketManager(t)
+	payments := &countingPaymentProvider{FakePaymentProvider: NewFakePaymentProvider()}
+	tm.Payments = payments
+	ctx := context.Background()
+
+	declined, err := tm.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		User: &pb.User{Email: "declined@example.com"}, From: "London", To: "France", PaymentToken: FakeDeclinedToken,
+	})
+	require.NoError(t, err)
+	waiting, err := tm.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		User: &pb.User{Email: "waiting@example.com"}, From: "London", To: "France", PaymentToken: "tok_visa",
+	})
+	require.NoError(t, err)
+
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: receipt.BookingReference})
+	require.NoError(t, err)
+
+	dropped, err := tm.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{WaitlistId: declined.WaitlistId})
+	require.NoError(t, err)
+	assert.Equal(t, "payment declined", dropped.Failure)
+	assert.Zero(t, dropped.Position)
+	assert.Empty(t, dropped.BookingReference)
+
+	promoted, err := tm.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{WaitlistId: waiting.WaitlistId})
+	require.NoError(t, err)
+	assert.NotEmpty(t, promoted.BookingReference, "The next passenger gets the seat")
+
+	// Later releases do not try the declined entry again.
+	_, err = tm.RemoveUser(ctx, &pb.RemoveUserRequest{BookingReference: promoted.BookingReference})
+	require.NoError(t, err)
+	assert.Equal(t, 2, payments.authorizations)
+}
+
+// countingPaymentProvider counts the authorizations asked of a fake provider.
+type countingPaymentProvider struct {
+	*FakePaymentProvider
+	authorizations int
+}
+
+func (c *countingPaymentProvider) Authorize(ctx context.Context, req PaymentRequest) (string, error) {
+	c.authorizations++
+	return c.FakePaymentProvider.Authorize(ctx, req)
+}
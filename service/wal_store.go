@@ -0,0 +1,300 @@
+package service
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+)
+
+const (
+	walFileName      = "wal.log"
+	snapshotFileName = "snapshot.json"
+
+	// walHeaderSize is the length and CRC prefix written before every record.
+	walHeaderSize = 8
+)
+
+var (
+	walCRCTable = crc32.MakeTable(crc32.Castagnoli)
+	// walMaxRecordSize bounds a single record so a corrupt length cannot trigger a huge allocation.
+	walMaxRecordSize = 64 << 20
+	// errShortRecord is a record cut off by the end of the log.
+	errShortRecord = errors.New("short record")
+)
+
+// WALStore persists booking state as a write-ahead log of mutations plus a
+// periodically compacted snapshot. Every commit is appended to the log and
+// synced before it returns; after every snapshotEvery commits the full state is
+// written to a snapshot and the log is truncated.
+//
+// Each record is framed as a little-endian uint32 payload length, a CRC-32C
+// of the payload and the JSON payload itself. On open, a trailing record that
+// is incomplete or fails its checksum is treated as a torn write and cut off;
+// a damaged record with more of the log after it is corruption, and opening
+// fails.
+type WALStore struct {
+	mu            sync.Mutex
+	dir           string
+	log           *os.File
+	state         *BookingState
+	seq           uint64
+	pending       int
+	snapshotEvery int
+}
+
+// walRecord is the JSON payload of a single log record.
+type walRecord struct {
+	Seq        uint64                     `json:"seq"`
+	Receipts   map[string]json.RawMessage `json:"receipts,omitempty"`
+	Seats      storedSeats                `json:"seats,omitempty"`
+	Refunds    map[string]json.RawMessage `json:"refunds,omitempty"`
+	PromoCodes map[string]json.RawMessage `json:"promo_codes,omitempty"`
+	Sections   map[string]*SectionState   `json:"sections,omitempty"`
+}
+
+// NewWALStore opens or creates a WAL store in dir, replaying the snapshot and
+// log found there. A snapshot is taken after every snapshotEvery commits.
+func NewWALStore(dir string, snapshotEvery int) (*WALStore, error) {
+	if snapshotEvery <= 0 {
+		return nil, fmt.Errorf("snapshot interval must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create wal directory: %w", err)
+	}
+
+	state, seq, err := readStateFile(filepath.Join(dir, snapshotFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filepath.Join(dir, walFileName), os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open wal: %w", err)
+	}
+
+	w := &WALStore{dir: dir, log: file, state: state, seq: seq, snapshotEvery: snapshotEvery}
+	if err := w.replay(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// replay applies log records newer than the snapshot and truncates a torn tail.
+func (w *WALStore) replay() error {
+	if _, err := w.log.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek wal: %w", err)
+	}
+	info, err := w.log.Stat()
+	if err != nil {
+		return fmt.Errorf("stat wal: %w", err)
+	}
+
+	reader := bufio.NewReader(w.log)
+	var offset int64
+	for {
+		record, size, err := readWALRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		// Only the last record can be torn by a crash; a bad record with
+		// more of the log after it means the log is damaged, and cutting it
+		// off there would lose every later commit.
+		if err != nil && !errors.Is(err, errShortRecord) && offset+size < info.Size() {
+			return fmt.Errorf("wal record at offset %d is corrupt: %w", offset, err)
+		}
+		if err != nil {
+			log.Printf("WAL torn record at offset %d, truncating: %v", offset, err)
+			if err := w.log.Truncate(offset); err != nil {
+				return fmt.Errorf("truncate wal: %w", err)
+			}
+			if err := w.log.Sync(); err != nil {
+				return fmt.Errorf("sync wal: %w", err)
+			}
+			break
+		}
+		offset += size
+
+		// Records at or below the snapshot sequence were already compacted;
+		// they survive only if a crash hit between snapshot and truncation.
+		if record.Seq <= w.seq {
+			continue
+		}
+
+		receipts, err := decodeMessages("receipt", record.Receipts, func() *pb.TicketReceipt { return &pb.TicketReceipt{} })
+		if err != nil {
+			return fmt.Errorf("replay wal record %d: %w", record.Seq, err)
+		}
+		refunds, err := decodeMessages("refund", record.Refunds, func() *pb.RefundRecord { return &pb.RefundRecord{} })
+		if err != nil {
+			return fmt.Errorf("replay wal record %d: %w", record.Seq, err)
+		}
+		promoCodes, err := decodeMessages("promo code", record.PromoCodes, func() *pb.PromoCode { return &pb.PromoCode{} })
+		if err != nil {
+			return fmt.Errorf("replay wal record %d: %w", record.Seq, err)
+		}
+		w.state.Apply(&Mutation{Receipts: receipts, Seats: record.Seats, Refunds: refunds, PromoCodes: promoCodes, Sections: record.Sections})
+		w.seq = record.Seq
+		w.pending++
+	}
+
+	if _, err := w.log.Seek(offset, io.SeekStart); err != nil {
+		return fmt.Errorf("seek wal: %w", err)
+	}
+	return nil
+}
+
+// readWALRecord reads one framed record, returning the number of bytes it
+// takes up in the log, which is also known for a damaged record once its
+// header is read. It returns io.EOF only at a clean record boundary, and
+// errShortRecord for a record cut off by the end of the log.
+func readWALRecord(reader io.Reader) (*walRecord, int64, error) {
+	header := make([]byte, walHeaderSize)
+	n, err := io.ReadFull(reader, header)
+	if err == io.EOF {
+		return nil, 0, io.EOF
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: header of %d bytes", errShortRecord, n)
+	}
+
+	length := binary.LittleEndian.Uint32(header[0:4])
+	checksum := binary.LittleEndian.Uint32(header[4:8])
+	size := int64(walHeaderSize) + int64(length)
+	if int64(length) > int64(walMaxRecordSize) {
+		return nil, size, fmt.Errorf("record length %d exceeds limit", length)
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, size, fmt.Errorf("%w: payload", errShortRecord)
+	}
+	if crc32.Checksum(payload, walCRCTable) != checksum {
+		return nil, size, errors.New("checksum mismatch")
+	}
+
+	record := &walRecord{}
+	if err := json.Unmarshal(payload, record); err != nil {
+		return nil, size, fmt.Errorf("decode record: %w", err)
+	}
+
+	return record, size, nil
+}
+
+// Load returns a copy of the recovered state.
+func (w *WALStore) Load() (*BookingState, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.state.Clone(), nil
+}
+
+// Commit appends the mutation to the log, syncs it and applies it to the state.
+func (w *WALStore) Commit(mutation *Mutation) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	receipts, err := encodeMessages("receipt", mutation.Receipts)
+	if err != nil {
+		return err
+	}
+	refunds, err := encodeMessages("refund", mutation.Refunds)
+	if err != nil {
+		return err
+	}
+	promoCodes, err := encodeMessages("promo code", mutation.PromoCodes)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(walRecord{Seq: w.seq + 1, Receipts: receipts, Seats: mutation.Seats, Refunds: refunds, PromoCodes: promoCodes, Sections: mutation.Sections})
+	if err != nil {
+		return fmt.Errorf("encode wal record: %w", err)
+	}
+	// A record over the limit would be taken for a torn write on replay and
+	// cut off with every commit after it.
+	if len(payload) > walMaxRecordSize {
+		return fmt.Errorf("wal record of %d bytes exceeds the %d byte limit", len(payload), walMaxRecordSize)
+	}
+
+	frame := make([]byte, walHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(frame[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(frame[4:8], crc32.Checksum(payload, walCRCTable))
+	copy(frame[walHeaderSize:], payload)
+
+	offset, err := w.log.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("seek wal: %w", err)
+	}
+	if _, err := w.log.Write(frame); err != nil {
+		w.rewind(offset)
+		return fmt.Errorf("append wal: %w", err)
+	}
+	if err := w.log.Sync(); err != nil {
+		w.rewind(offset)
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	w.seq++
+	w.state.Apply(mutation)
+	w.pending++
+
+	if w.pending >= w.snapshotEvery {
+		if err := w.snapshot(); err != nil {
+			// The record is already durable in the log, so the commit
+			// stands; compaction is retried on the next commit.
+			log.Printf("WAL snapshot failed: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// rewind drops a partially written record so later appends do not land after it.
+func (w *WALStore) rewind(offset int64) {
+	if err := w.log.Truncate(offset); err != nil {
+		log.Printf("WAL rewind failed: %v", err)
+		return
+	}
+	if _, err := w.log.Seek(offset, io.SeekStart); err != nil {
+		log.Printf("WAL rewind failed: %v", err)
+	}
+}
+
+// snapshot writes the current state and truncates the log. Callers must hold w.mu.
+func (w *WALStore) snapshot() error {
+	if err := writeStateFile(filepath.Join(w.dir, snapshotFileName), w.state, w.seq); err != nil {
+		return err
+	}
+	if err := w.log.Truncate(0); err != nil {
+		return fmt.Errorf("truncate wal: %w", err)
+	}
+	if _, err := w.log.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek wal: %w", err)
+	}
+	if err := w.log.Sync(); err != nil {
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	w.pending = 0
+	return nil
+}
+
+// Close closes the log file.
+func (w *WALStore) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.log.Close()
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/nandha854/train-ticket-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitTestReceipt(t *testing.T, store Store, email string, seat int) {
+	t.Helper()
+	require.NoError(t, store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{email: {User: &pb.User{Email: email}, Seat: &pb.Seat{SeatNumber: int32(seat), Section: "A"}}},
+		Seats:    map[string]map[int][]string{"A": {seat: {"Assigned"}}},
+	}))
+}
+
+func TestWALStoreReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewWALStore(dir, 100)
+	require.NoError(t, err)
+	commitTestReceipt(t, store, "a@example.com", 1)
+	commitTestReceipt(t, store, "b@example.com", 2)
+	require.NoError(t, store.Commit(&Mutation{
+		Receipts: map[string]*pb.TicketReceipt{"a@example.com": nil},
+		Seats:    map[string]map[int][]string{"A": {1: {"Available"}}},
+	}))
+	require.NoError(t, store.Close())
+
+	reopened, err := NewWALStore(dir, 100)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	state, err := reopened.Load()
+	require.NoError(t, err)
+	assert.NotContains(t, state.Receipts, "a@example.com")
+	assert.Contains(t, state.Receipts, "b@example.com")
+	assert.Equal(t, []string{"Available"}, state.Seats["A"][1])
+	assert.Equal(t, []string{"Assigned"}, state.Seats["A"][2])
+}
+
+func TestWALStoreSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewWALStore(dir, 2)
+	require.NoError(t, err)
+	commitTestReceipt(t, store, "a@example.com", 1)
+	commitTestReceipt(t, store, "b@example.com", 2)
+
+	info, err := os.Stat(filepath.Join(dir, walFileName))
+	require.NoError(t, err)
+	assert.Zero(t, info.Size(), "Log should be truncated after a snapshot")
+	assert.FileExists(t, filepath.Join(dir, snapshotFileName))
+
+	commitTestReceipt(t, store, "c@example.com", 3)
+	require.NoError(t, store.Close())
+
+	reopened, err := NewWALStore(dir, 2)
+	require.NoError(t, err)
+	defer reopened.Close()
+
+	state, err := reopened.Load()
+	require.NoError(t, err)
+	assert.Len(t, state.Receipts, 3, "Snapshot and log should both be replayed")
+}
+
+func TestWALStoreTornRecord(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage func(data []byte) []byte
+	}{
+		{
+			name:   "Partial header",
+			damage: func(data []byte) []byte { return append(data, 0x10, 0x00) },
+		},
+		{
+			name:   "Partial payload",
+			damage: func(data []byte) []byte { return data[:len(data)-3] },
+		},
+		{
+			name: "Checksum mismatch",
+			damage: func(data []byte) []byte {
+				data[len(data)-2] ^= 0xff
+				return data
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			store, err := NewWALStore(dir, 100)
+			require.NoError(t, err)
+			commitTestReceipt(t, store, "a@example.com", 1)
+			commitTestReceipt(t, store, "b@example.com", 2)
+			require.NoError(t, store.Close())
+
+			path := filepath.Join(dir, walFileName)
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, tt.damage(data), 0o644))
+
+			reopened, err := NewWALStore(dir, 100)
+			require.NoError(t, err)
+
+			state, err := reopened.Load()
+			require.NoError(t, err)
+			assert.Contains(t, state.Receipts, "a@example.com", "Records before the torn one should survive")
+
+			// Appending after recovery must produce a log that replays cleanly.
+			commitTestReceipt(t, reopened, "c@example.com", 3)
+			require.NoError(t, reopened.Close())
+
+			again, err := NewWALStore(dir, 100)
+			require.NoError(t, err)
+			defer again.Close()
+
+			state, err = again.Load()
+			require.NoError(t, err)
+			assert.Contains(t, state.Receipts, "c@example.com")
+		})
+	}
+}
+
+func TestWALStoreCorruptRecord(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewWALStore(dir, 100)
+	require.NoError(t, err)
+	commitTestReceipt(t, store, "a@example.com", 1)
+	commitTestReceipt(t, store, "b@example.com", 2)
+	require.NoError(t, store.Close())
+
+	// Damage the payload of the first record, which has another after it.
+	path := filepath.Join(dir, walFileName)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[walHeaderSize+2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	_, err = NewWALStore(dir, 100)
+	assert.ErrorContains(t, err, "wal record at offset 0 is corrupt")
+	after, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, data, after, "A corrupt log should be left for inspection")
+}
+
+func TestWALStoreRecordTooLarge(t *testing.T) {
+	limit := walMaxRecordSize
+	walMaxRecordSize = 200
+	defer func() { walMaxRecordSize = limit }()
+	dir := t.TempDir()
+
+	store, err := NewWALStore(dir, 100)
+	require.NoError(t, err)
+	commitTestReceipt(t, store, "a@example.com", 1)
+	err = store.Commit(&Mutation{Seats: map[string]map[int][]string{"A": initializeSeats(20, 1)}})
+	assert.ErrorContains(t, err, "exceeds the 200 byte limit")
+	commitTestReceipt(t, store, "b@example.com", 2)
+	require.NoError(t, store.Close())
+
+	reopened, err := NewWALStore(dir, 100)
+	require.NoError(t, err)
+	defer reopened.Close()
+	state, err := reopened.Load()
+	require.NoError(t, err)
+	assert.Len(t, state.Receipts, 2, "Commits after a rejected record should survive")
+	assert.NotContains(t, state.Seats["A"], 20)
+}